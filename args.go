@@ -0,0 +1,34 @@
+package sflags
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SetArgs sets values of positional arguments from vals,
+// usually vals are remaining command line arguments after flags were parsed.
+// Every argument takes one value, except variadic one, that takes all the rest.
+func SetArgs(args []*Arg, vals []string) error {
+	for _, arg := range args {
+		if len(vals) == 0 {
+			if arg.Required {
+				return fmt.Errorf("required argument %s not provided", arg.Name)
+			}
+			continue
+		}
+		n := 1
+		if arg.Variadic {
+			n = len(vals)
+		}
+		for _, val := range vals[:n] {
+			if err := arg.Value.Set(val); err != nil {
+				return fmt.Errorf("invalid value %q for argument %s: %w", val, arg.Name, err)
+			}
+		}
+		vals = vals[n:]
+	}
+	if len(vals) > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(vals, " "))
+	}
+	return nil
+}
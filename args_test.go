@@ -0,0 +1,109 @@
+package sflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type argsCfg struct {
+	Verbose bool
+	Src     string   `arg:"source,required" desc:"source file"`
+	Dst     string   `arg:"" default:"out"`
+	Rest    []string `arg:"files"`
+}
+
+func TestParseStructWithArgs(t *testing.T) {
+	cfg := &argsCfg{}
+	flags, args, err := ParseStructWithArgs(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(flags))
+	assert.Equal(t, "verbose", flags[0].Name)
+	assert.Equal(t, []*Arg{
+		{
+			Name:     "source",
+			Usage:    "source file",
+			Value:    newStringValue(&cfg.Src),
+			Required: true,
+		},
+		{
+			Name:     "dst",
+			Value:    newStringValue(&cfg.Dst),
+			DefValue: "out",
+		},
+		{
+			Name:     "files",
+			Value:    newStringSliceValue(&cfg.Rest),
+			DefValue: "[]",
+			Variadic: true,
+		},
+	}, args)
+
+	flags, err = ParseStruct(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(flags))
+}
+
+func TestParseStructWithArgs_Errors(t *testing.T) {
+	_, _, err := ParseStructWithArgs(&struct {
+		Files []string `arg:""`
+		Dst   string   `arg:""`
+	}{})
+	assert.EqualError(t, err, "variadic argument files must be the last one")
+
+	_, _, err = ParseStructWithArgs(&struct {
+		Src string `arg:""`
+		Dst string `arg:",required"`
+	}{})
+	assert.EqualError(t, err, "required argument dst can't follow optional argument src")
+}
+
+func TestSetArgs(t *testing.T) {
+	tt := []struct {
+		name   string
+		vals   []string
+		expCfg *argsCfg
+		expErr string
+	}{
+		{
+			name:   "required only",
+			vals:   []string{"in"},
+			expCfg: &argsCfg{Src: "in", Dst: "out"},
+		},
+		{
+			name:   "variadic",
+			vals:   []string{"in", "dst", "a", "b,c"},
+			expCfg: &argsCfg{Src: "in", Dst: "dst", Rest: []string{"a", "b", "c"}},
+		},
+		{
+			name:   "missing required",
+			vals:   []string{},
+			expErr: "required argument source not provided",
+		},
+	}
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &argsCfg{}
+			_, args, err := ParseStructWithArgs(cfg)
+			require.NoError(t, err)
+			err = SetArgs(args, test.vals)
+			if test.expErr != "" {
+				require.EqualError(t, err, test.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expCfg, cfg)
+		})
+	}
+}
+
+func TestSetArgs_Unexpected(t *testing.T) {
+	_, args, err := ParseStructWithArgs(&struct {
+		Count int `arg:""`
+	}{})
+	require.NoError(t, err)
+	assert.EqualError(t, SetArgs(args, []string{"1", "2", "3"}), "unexpected arguments: 2 3")
+	assert.EqualError(t, SetArgs(args, []string{"a"}),
+		`invalid value "a" for argument count: strconv.ParseInt: parsing "a": invalid syntax`)
+}
@@ -0,0 +1,88 @@
+package sflags
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArrayValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     []string
+		out    string
+		expErr string
+	}{
+		{"all at once", []string{"1,2,3"}, "[1,2,3]", ""},
+		{"one per flag", []string{"1", "2", "3"}, "[1,2,3]", ""},
+		{"too few", []string{"1,2"}, "[0,0,0]", "expected 3 elements or one per flag, got 2"},
+		{"too many", []string{"1,2,3,4"}, "[0,0,0]", "too many elements, expected 3"},
+		{"too many flags", []string{"1,2,3", "4"}, "[1,2,3]", "too many elements, expected 3"},
+		{"mixed", []string{"1", "2,3"}, "[1,0,0]", "expected 3 elements or one per flag, got 2"},
+		{"invalid", []string{"1,x,3"}, "[0,0,0]", `strconv.ParseInt: parsing "x": invalid syntax`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var a [3]int
+			v := parseGeneratedArray(reflect.ValueOf(&a).Elem())
+			require.NotNil(t, v)
+			var err error
+			for _, in := range test.in {
+				err = v.Set(in)
+			}
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.out, v.String())
+			assert.Equal(t, a, v.(Getter).Get())
+			assert.Equal(t, "intArray", v.Type())
+			assert.True(t, v.(RepeatableFlag).IsCumulative())
+		})
+	}
+}
+
+func TestParseStruct_Arrays(t *testing.T) {
+	cfg := &struct {
+		Coords   [2]float64 `default:"1.5,2.5"`
+		Version  [3]int     `sep:"."`
+		Timeouts [2]time.Duration
+		Names    [2]string `choices:"a,b"`
+		Empty    [0]int
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 4)
+	assert.Equal(t, [2]float64{1.5, 2.5}, cfg.Coords)
+	assert.Equal(t, "[1.5,2.5]", flags[0].DefValue)
+	assert.Equal(t, "[0,0,0]", flags[1].DefValue)
+
+	assert.NoError(t, CheckArrays(flags))
+	require.NoError(t, flags[0].Value.Set("3"))
+	assert.Equal(t, [2]float64{3, 0}, cfg.Coords)
+	assert.EqualError(t, CheckArrays(flags), `array flag(s) "coords" (1 of 2 elements) set partially`)
+	require.NoError(t, flags[0].Value.Set("4"))
+	assert.Equal(t, [2]float64{3, 4}, cfg.Coords)
+	assert.NoError(t, CheckArrays(flags))
+
+	require.NoError(t, flags[1].Value.Set("1.22.3"))
+	assert.Equal(t, [3]int{1, 22, 3}, cfg.Version)
+
+	cfg.Version = [3]int{1, 2, 3}
+	flags, err = ParseStruct(cfg)
+	require.NoError(t, err)
+	require.NoError(t, flags[1].Value.Set("2"))
+	require.NoError(t, flags[1].Value.Set("5"))
+	assert.Equal(t, [3]int{2, 5, 0}, cfg.Version)
+	assert.EqualError(t, CheckArrays(flags), `array flag(s) "version" (2 of 3 elements) set partially`)
+
+	require.NoError(t, flags[2].Value.Set("1s,1m"))
+	assert.Equal(t, [2]time.Duration{time.Second, time.Minute}, cfg.Timeouts)
+	assert.Equal(t, "[1s,1m0s]", flags[2].Value.String())
+
+	assert.EqualError(t, flags[3].Value.Set("a,c"), `invalid value "c", allowed values are: a, b`)
+}
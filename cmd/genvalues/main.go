@@ -77,6 +77,20 @@ func parseGeneratedMap(value interface{}) Value {
 	}
 }
 
+func parseGeneratedArray(value reflect.Value) Value {
+	if value.Kind() != reflect.Array || value.Len() == 0 || !value.CanAddr() {
+		return nil
+	}
+	switch value.Index(0).Addr().Interface().(type) {
+	{{range .Values}}\nn
+	case *{{.Type}}:
+		return new{{.|Name}}ArrayValue(value)
+	{{end}}\nn
+	default:
+		return nil
+	}
+}
+
 {{range .Values}}
 {{if not .NoValueParser}}
 // -- {{.Type}} Value
@@ -126,13 +140,15 @@ func (v *{{.|ValueName}}) String() string {
 }
 
 func (v *{{.|ValueName}}) Type() string { return "{{.|Type}}" }
+{{end}}
 
-{{ if not .NoSlice }}
+{{ if not (or .NoSlice .NoSliceValue) }}
 // -- {{.Type}}Slice Value
 
 type {{.|SliceValueName}} struct{
 	value   *[]{{.Type}}
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*{{.|SliceValueName}})(nil)
@@ -146,8 +162,13 @@ func new{{.|Name}}SliceValue(slice *[]{{.Type}}) *{{.|SliceValueName}}  {
 	}
 }
 
+func (v *{{.|SliceValueName}}) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *{{.|SliceValueName}}) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 	{{if .Parser }}
 	out := make([]{{.Type}}, len(ss))
 	for i, s := range ss {
@@ -205,6 +226,7 @@ func (v *{{.|SliceValueName}}) IsCumulative() bool {
 // -- {{ MapValueName $value . }}
 type {{ MapValueName $value . }} struct {
 	value *map[{{.}}]{{$value.Type}}
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*{{MapValueName $value .}})(nil)
@@ -218,11 +240,13 @@ func new{{MapValueName $value . | Title}}(m *map[{{.}}]{{$value.Type}}) *{{MapVa
 	}
 }
 
+func (v *{{MapValueName $value .}}) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *{{MapValueName $value .}}) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-    if len(ss) < 2 {
-        return errors.New("invalid map flag syntax, use -map=key1:val1")
-    }
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
 
 	{{ $kindVal := KindValue . }}
 
@@ -292,9 +316,88 @@ func (v *{{MapValueName $value .}}) IsCumulative() bool {
 {{end}}
 {{end}}
 
+
 {{end}}
 
+{{range .Values}}
+// -- {{.Type}}Array Value
+
+type {{.|ArrayValueName}} struct {
+	value   []{{.Type}} // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*{{.|ArrayValueName}})(nil)
+var _ Value = (*{{.|ArrayValueName}})(nil)
+var _ Getter = (*{{.|ArrayValueName}})(nil)
+
+func new{{.|Name}}ArrayValue(array reflect.Value) *{{.|ArrayValueName}} {
+	return &{{.|ArrayValueName}}{
+		value: array.Slice(0, array.Len()).Interface().([]{{.Type}}),
+		array: array,
+	}
+}
+
+func (v *{{.|ArrayValueName}}) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *{{.|ArrayValueName}}) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+	{{if .Parser }}
+	out := make([]{{.Type}}, len(ss))
+	for i, s := range ss {
+		parsed, err := {{.Parser}}
+		if err != nil {
+			return err
+		}
+		{{if .Convert}}\nn
+		out[i] = ({{.Type}})(parsed)
+		{{else}}\nn
+		out[i] = parsed
+		{{end}}\nn
+	}
+	{{ else }}out := ss{{end}}
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *{{.|ArrayValueName}}) arrayElems() (changed, size int) { return v.changed, len(v.value) }
 
+func (v *{{.|ArrayValueName}}) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *{{.|ArrayValueName}}) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, new{{.|Name}}Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *{{.|ArrayValueName}}) Type() string { return "{{.|Type}}Array" }
+
+func (v *{{.|ArrayValueName}}) IsCumulative() bool {
+	return true
+}
 {{end}}
 `
 	testTmpl = `package sflags
@@ -458,6 +561,17 @@ func Test{{MapValueName $value $keyType | Title}}(t *testing.T) {
 
 {{end}}
 
+{{range .Values}}
+func Test{{.|Name}}ArrayValue_Zero(t *testing.T) {
+	nilValue := new({{.|ArrayValueName}})
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*{{.|ArrayValueName}})(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+{{end}}
+
 func TestParseGeneratedMap_NilDefault(t *testing.T) {
 	a := new(bool)
 	v := parseGeneratedMap(a)
@@ -515,6 +629,7 @@ type value struct {
 	Import        []string    `json:"import"`
 	Tests         []test      `json:"tests"`
 	NoSlice       bool        `json:"no_slice"`
+	NoSliceValue  bool        `json:"no_slice_value"` // slice Value is written by hand, but parsed and tested as generated ones
 	SliceTests    []sliceTest `json:"slice_tests"`
 	NoMap         bool        `json:"no_map"`
 	MapTests      []mapTest   `json:"map_tests"`
@@ -574,6 +689,10 @@ func main() {
 			name := valueName(v)
 			return camelToLower(name) + "SliceValue"
 		},
+		"ArrayValueName": func(v *value) string {
+			name := valueName(v)
+			return camelToLower(name) + "ArrayValue"
+		},
 		"MapValueName": func(v *value, kind string) string {
 			name := valueName(v)
 
@@ -593,7 +712,7 @@ func main() {
 				return randStr(5)
 			}
 
-			return rand.Intn(8)
+			return rnd.Intn(8)
 		},
 		"Name": valueName,
 		"Plural": func(v *value) string {
@@ -777,10 +896,14 @@ func split(src string) (entries []string) {
 
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
+// rnd generates test data with a fixed seed,
+// so regenerated tests don't change unless values.json does.
+var rnd = rand.New(rand.NewSource(1))
+
 func randStr(n int) string {
 	b := make([]byte, n)
 	for i := range b {
-		b[i] = letterBytes[rand.Intn(len(letterBytes))]
+		b[i] = letterBytes[rnd.Intn(len(letterBytes))]
 	}
 	return string(b)
 }
@@ -0,0 +1,41 @@
+package sflags
+
+// Command structure describes a command with its flags, positional arguments
+// and subcommands, it might be used by cli libraries for command tree generation.
+type Command struct {
+	Name     string // name as it appears on command line; empty for the root command
+	Usage    string // help message
+	Flags    []*Flag
+	Args     []*Arg
+	Commands []*Command // subcommands
+	Hidden   bool
+
+	selected bool
+}
+
+// Select marks c as a command chosen on command line.
+// It's called by generators, when a subcommand is executed.
+func (c *Command) Select() { c.selected = true }
+
+// Selected returns the deepest selected subcommand of c,
+// or c itself if none of subcommands was selected.
+func (c *Command) Selected() *Command {
+	if subCmd := c.selectedSubCommand(); subCmd != nil {
+		return subCmd
+	}
+	return c
+}
+
+// selectedSubCommand returns the deepest selected subcommand of c or nil.
+// Generators might select only the executed command, not the whole path to it.
+func (c *Command) selectedSubCommand() *Command {
+	for _, subCmd := range c.Commands {
+		if selected := subCmd.selectedSubCommand(); selected != nil {
+			return selected
+		}
+		if subCmd.selected {
+			return subCmd
+		}
+	}
+	return nil
+}
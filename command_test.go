@@ -0,0 +1,124 @@
+package sflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type serveCmd struct {
+	Port int    `default:"8080"`
+	Dir  string `arg:""`
+}
+
+type migrateUpCmd struct {
+	Steps int
+}
+
+type migrateCmd struct {
+	DryRun bool
+	Up     migrateUpCmd `cmd:"" desc:"apply migrations"`
+}
+
+type rootCmd struct {
+	Verbose bool
+	Serve   serveCmd    `cmd:"serve" desc:"start server"`
+	Migrate *migrateCmd `cmd:",hidden"`
+}
+
+func TestParseCommand(t *testing.T) {
+	cfg := &rootCmd{}
+	cmd, err := ParseCommand(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Migrate)
+
+	assert.Equal(t, &Command{
+		Flags: []*Flag{
+			{
+				Name:     "verbose",
+				EnvNames: []string{"VERBOSE"},
+				DefValue: "false",
+				Value:    newBoolValue(&cfg.Verbose),
+			},
+		},
+		Commands: []*Command{
+			{
+				Name:  "serve",
+				Usage: "start server",
+				Flags: []*Flag{
+					{
+						Name:     "port",
+						EnvNames: []string{"PORT"},
+						DefValue: "8080",
+						Value:    newIntValue(&cfg.Serve.Port),
+					},
+				},
+				Args: []*Arg{
+					{
+						Name:  "dir",
+						Value: newStringValue(&cfg.Serve.Dir),
+					},
+				},
+			},
+			{
+				Name:   "migrate",
+				Hidden: true,
+				Flags: []*Flag{
+					{
+						Name:     "dry-run",
+						EnvNames: []string{"DRY_RUN"},
+						DefValue: "false",
+						Value:    newBoolValue(&cfg.Migrate.DryRun),
+					},
+				},
+				Commands: []*Command{
+					{
+						Name:  "up",
+						Usage: "apply migrations",
+						Flags: []*Flag{
+							{
+								Name:     "steps",
+								EnvNames: []string{"STEPS"},
+								DefValue: "0",
+								Value:    newIntValue(&cfg.Migrate.Up.Steps),
+							},
+						},
+					},
+				},
+			},
+		},
+	}, cmd)
+
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, cmd.Flags, flags)
+}
+
+func TestParseCommand_NotStruct(t *testing.T) {
+	_, err := ParseCommand(&struct {
+		Serve string `cmd:""`
+	}{})
+	assert.EqualError(t, err, "field Serve: subcommand must be a structure")
+}
+
+func TestCommand_Selected(t *testing.T) {
+	cmd, err := ParseCommand(&rootCmd{})
+	require.NoError(t, err)
+	assert.Equal(t, cmd, cmd.Selected())
+
+	migrate := cmd.Commands[1]
+	migrate.Select()
+	assert.Equal(t, migrate, cmd.Selected())
+
+	migrate.Commands[0].Select()
+	assert.Equal(t, "up", cmd.Selected().Name)
+}
+
+func TestCommand_SelectedLeaf(t *testing.T) {
+	cmd, err := ParseCommand(&rootCmd{})
+	require.NoError(t, err)
+	// generators might select only executed command
+	cmd.Commands[1].Commands[0].Select()
+	assert.Equal(t, "up", cmd.Selected().Name)
+}
@@ -0,0 +1,135 @@
+package sflags
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+)
+
+// DumpFormat describes output format of Dump.
+type DumpFormat int
+
+// Dump formats.
+const (
+	DumpText  DumpFormat = iota // one "name=value source=... env=..." line per flag
+	DumpJSON                    // list of objects with name, value, source and env fields
+	DumpTable                   // aligned table with a header
+)
+
+// secretMask replaces values of secret flags in Dump.
+const secretMask = "******"
+
+// dumpEntry describes one flag in Dump output.
+type dumpEntry struct {
+	Name   string      `json:"name"`
+	Value  interface{} `json:"value"`
+	Source string      `json:"source"`
+	Env    []string    `json:"env,omitempty"`
+}
+
+// Dump writes effective values of flags to w in format,
+// e.g. to log configuration on start. Hidden flags are skipped,
+// values of secret flags are masked.
+func Dump(w io.Writer, flags []*Flag, format DumpFormat) error {
+	entries := make([]dumpEntry, 0, len(flags))
+	for _, flag := range flags {
+		if flag.Hidden {
+			continue
+		}
+		entries = append(entries, dumpEntry{
+			Name:   flag.Name,
+			Value:  dumpValue(flag, format == DumpJSON),
+			Source: flag.Source().String(),
+			Env:    flag.EnvNames,
+		})
+	}
+	switch format {
+	case DumpText:
+		for _, entry := range entries {
+			line := entry.Name + "=" + quoteIfNeeded(fmt.Sprint(entry.Value)) +
+				" source=" + quoteIfNeeded(entry.Source)
+			if len(entry.Env) > 0 {
+				line += " env=" + strings.Join(entry.Env, ",")
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	case DumpJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	case DumpTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "NAME\tVALUE\tSOURCE\tENV")
+		for _, entry := range entries {
+			fmt.Fprintf(tw, "%s\t%v\t%s\t%s\n", entry.Name, entry.Value, entry.Source, strings.Join(entry.Env, ", "))
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown dump format %d", format)
+}
+
+// dumpValue returns a masked or string value of the flag.
+// If typed is set, values of basic types are returned as is for JSON.
+func dumpValue(flag *Flag, typed bool) interface{} {
+	str := flag.Value.String()
+	if flag.Secret {
+		if str == "" {
+			return ""
+		}
+		return secretMask
+	}
+	if !typed {
+		return str
+	}
+	if getter, casted := unwrapValue(flag.Value).(Getter); casted && isBasic(getter.Get()) {
+		return getter.Get()
+	}
+	return str
+}
+
+// isBasic checks that val is a bool, number or string
+// or a slice or map of them, that are rendered well in JSON.
+func isBasic(val interface{}) bool {
+	if val == nil {
+		return false
+	}
+	// e.g. time.Duration is better as a string
+	if _, casted := val.(fmt.Stringer); casted {
+		return false
+	}
+	typ := reflect.TypeOf(val)
+	switch typ.Kind() {
+	case reflect.Slice:
+		typ = typ.Elem()
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return false
+		}
+		typ = typ.Elem()
+	}
+	if typ.Implements(reflect.TypeOf((*fmt.Stringer)(nil)).Elem()) {
+		return false
+	}
+	// uint8 is skipped, because []byte is base64 encoded in JSON.
+	switch typ.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func quoteIfNeeded(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return fmt.Sprintf("%q", s)
+	}
+	return s
+}
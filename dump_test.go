@@ -0,0 +1,74 @@
+package sflags
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dumpTestFlags(t *testing.T) []*Flag {
+	cfg := &struct {
+		Host     string `desc:"http host"`
+		Port     int    `default:"8080" env:"PORT,HTTP_PORT"`
+		Password string `flag:",secret"`
+		Token    string `secret:"true"`
+		Timeout  time.Duration
+		Tags     []string
+		Internal string `flag:",hidden"`
+	}{
+		Host:     "my host",
+		Password: "qwerty",
+		Timeout:  time.Second,
+		Tags:     []string{"a", "b"},
+	}
+	flags, err := ParseStruct(cfg, TrackSource())
+	require.NoError(t, err)
+	require.NoError(t, flags[0].SetFrom("example.com", Source{Kind: SourceEnv, Name: "HOST"}))
+	return flags
+}
+
+func TestDump(t *testing.T) {
+	flags := dumpTestFlags(t)
+	assert.True(t, flags[2].Secret)
+	assert.True(t, flags[3].Secret)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Dump(buf, flags, DumpText))
+	assert.Equal(t, `host=example.com source="env HOST" env=HOST
+port=8080 source="default tag" env=PORT,HTTP_PORT
+password=****** source=default env=PASSWORD
+token="" source=default env=TOKEN
+timeout=1s source=default env=TIMEOUT
+tags=[a,b] source=default env=TAGS
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, Dump(buf, flags, DumpTable))
+	assert.Equal(t, `NAME      VALUE        SOURCE       ENV
+host      example.com  env HOST     HOST
+port      8080         default tag  PORT, HTTP_PORT
+password  ******       default      PASSWORD
+token                  default      TOKEN
+timeout   1s           default      TIMEOUT
+tags      [a,b]        default      TAGS
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, Dump(buf, flags[1:3], DumpJSON))
+	assert.JSONEq(t, `[
+		{"name": "port", "value": 8080, "source": "default tag", "env": ["PORT", "HTTP_PORT"]},
+		{"name": "password", "value": "******", "source": "default", "env": ["PASSWORD"]}
+	]`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, Dump(buf, flags[4:6], DumpJSON))
+	assert.JSONEq(t, `[
+		{"name": "timeout", "value": "1s", "source": "default", "env": ["TIMEOUT"]},
+		{"name": "tags", "value": ["a", "b"], "source": "default", "env": ["TAGS"]}
+	]`, buf.String())
+
+	assert.EqualError(t, Dump(buf, flags, DumpFormat(10)), "unknown dump format 10")
+}
@@ -0,0 +1,202 @@
+package sflags
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxElemIndex limits indexes of slice elements, that are discovered
+// from environment variables and DiscoverFlags option, so a single
+// variable like UPSTREAM_99999999_HOST doesn't allocate a huge slice.
+const maxElemIndex = 1000
+
+// parseElems parses a slice or a map with string keys of structures
+// (or pointers to them) as nested structures, which flags are prefixed
+// by an index or a key of an element, e.g. "upstream-0-host" or "backend-primary-host".
+// Elements are the existing ones, declared by `size` tag for slices or
+// `keys` tag for maps, and discovered from environment variables
+// and DiscoverFlags option. It returns nil for other types.
+func parseElems(value reflect.Value, field reflect.StructField, flag *Flag, prefix string, opt opts, nestedOpts []OptFunc) (*Command, error) {
+	typ := value.Type()
+	if !isElems(typ) {
+		return nil, nil
+	}
+	isSlice := typ.Kind() == reflect.Slice
+	discovered, err := discoverElems(typ.Elem(), flag, prefix, opt, nestedOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &Command{Flags: []*Flag{}}
+	parseElem := func(elem reflect.Value, key string, elemOpts ...OptFunc) error {
+		elemOpts = append(nestedOpts[:len(nestedOpts):len(nestedOpts)],
+			append([]OptFunc{Prefix(prefix + key + opt.flagDivider)}, elemOpts...)...)
+		elemCmd, _, err := parseVal(elem, elemOpts...)
+		if err != nil {
+			return err
+		}
+		if elemCmd != nil {
+			cmd.Flags = append(cmd.Flags, elemCmd.Flags...)
+		}
+		return nil
+	}
+
+	if isSlice {
+		size := value.Len()
+		if sizeTag := field.Tag.Get(defaultSizeTag); sizeTag != "" {
+			declared, err := strconv.Atoi(sizeTag)
+			if err != nil || declared < 0 {
+				return nil, fmt.Errorf("invalid size %q", sizeTag)
+			}
+			size = max(size, declared)
+		}
+		for _, key := range discovered {
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 {
+				continue
+			}
+			if i > maxElemIndex {
+				return nil, fmt.Errorf("index %d is out of range, max index is %d", i, maxElemIndex)
+			}
+			size = max(size, i+1)
+		}
+		if size > value.Len() {
+			grown := reflect.MakeSlice(typ, size, size)
+			reflect.Copy(grown, value)
+			value.Set(grown)
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := parseElem(value.Index(i), strconv.Itoa(i)); err != nil {
+				return nil, err
+			}
+		}
+		return cmd, nil
+	}
+
+	if value.IsNil() {
+		value.Set(reflect.MakeMap(typ))
+	}
+	var keys []string
+	for _, key := range value.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	if keysTag := field.Tag.Get(defaultKeysTag); keysTag != "" {
+		keys = append(keys, strings.Split(keysTag, ",")...)
+	}
+	keys = append(keys, discovered...)
+	sort.Strings(keys)
+	for i, key := range keys {
+		if key == "" || i > 0 && key == keys[i-1] {
+			continue
+		}
+		mapKey := reflect.ValueOf(key).Convert(typ.Key())
+		// map elements aren't addressable, so a copy is parsed
+		// and stored back, unless elements are pointers.
+		ptr := reflect.New(typ.Elem())
+		if existing := value.MapIndex(mapKey); existing.IsValid() {
+			ptr.Elem().Set(existing)
+		}
+		var elemOpts []OptFunc
+		if typ.Elem().Kind() == reflect.Struct {
+			store := func() { value.SetMapIndex(mapKey, ptr.Elem()) }
+			if parentOnSet := opt.onSet; parentOnSet != nil {
+				store = func() {
+					value.SetMapIndex(mapKey, ptr.Elem())
+					parentOnSet()
+				}
+			}
+			elemOpts = append(elemOpts, onSet(store))
+		}
+		if err := parseElem(ptr.Elem(), key, elemOpts...); err != nil {
+			return nil, err
+		}
+		value.SetMapIndex(mapKey, ptr.Elem())
+	}
+	return cmd, nil
+}
+
+// isElems checks that typ is a slice or a map of structures,
+// which elements are parsed by parseElems.
+func isElems(typ reflect.Type) bool {
+	isSlice := typ.Kind() == reflect.Slice
+	isMap := typ.Kind() == reflect.Map && typ.Key().Kind() == reflect.String
+	return (isSlice || isMap) && isStructElem(typ.Elem())
+}
+
+// isStructElem checks that elements of typ are parsed as nested structures.
+func isStructElem(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct && !isValue(reflect.New(typ).Elem())
+}
+
+// discoverElems returns indexes or keys of elements, that are found in
+// names of environment variables and DiscoverFlags option, e.g. "primary"
+// for BACKEND_PRIMARY_HOST and "backend-primary-host".
+func discoverElems(elemType reflect.Type, flag *Flag, prefix string, opt opts, nestedOpts []OptFunc) ([]string, error) {
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	// an element of a recursive type, e.g. Children []node of node,
+	// has no elements itself, while its flag names are discovered.
+	for _, typ := range opt.discovering {
+		if typ == elemType {
+			return nil, nil
+		}
+	}
+	// names of flags and environment variables of an element without prefixes
+	elemCmd, _, err := parseVal(reflect.New(elemType).Elem(), append(nestedOpts[:len(nestedOpts):len(nestedOpts)],
+		Prefix(""), EnvPrefix(""), onSet(nil), discovering(elemType))...)
+	if err != nil {
+		return nil, err
+	}
+	var flagNames, envNames []string
+	for _, elemFlag := range elemCmd.Flags {
+		flagNames = append(flagNames, elemFlag.Name)
+		envNames = append(envNames, elemFlag.EnvNames...)
+	}
+
+	keys := cutElemKeys(opt.discover, prefix, opt.flagDivider, flagNames)
+	if len(flag.EnvNames) == 0 {
+		return keys, nil
+	}
+	var environ []string
+	for _, env := range os.Environ() {
+		name, _, _ := strings.Cut(env, "=")
+		environ = append(environ, name, strings.TrimSuffix(name, envFileSuffix))
+	}
+	envPrefix := opt.envPrefix + flagToEnv(prefix, opt.flagDivider, opt.envDivider)
+	for _, key := range cutElemKeys(environ, envPrefix, opt.envDivider, envNames) {
+		keys = append(keys, strings.ReplaceAll(strings.ToLower(key), opt.envDivider, opt.flagDivider))
+	}
+	return keys, nil
+}
+
+// cutElemKeys returns keys of names, that are prefix + key + divider + suffix.
+// The longest suffix is cut, so "primary-admin-port" is "admin-port"
+// of "primary" element rather than "port" of "primary-admin" one.
+func cutElemKeys(names []string, prefix, divider string, suffixes []string) []string {
+	var keys []string
+	for _, name := range names {
+		rest, found := strings.CutPrefix(name, prefix)
+		if !found {
+			continue
+		}
+		elemKey := ""
+		for _, suffix := range suffixes {
+			if key, found := strings.CutSuffix(rest, divider+suffix); found && key != "" &&
+				(elemKey == "" || len(key) < len(elemKey)) {
+				elemKey = key
+			}
+		}
+		if elemKey != "" && !hasOption(keys, elemKey) {
+			keys = append(keys, elemKey)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,147 @@
+package sflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type upstreamConfig struct {
+	Host string
+	Port int `default:"80"`
+}
+
+func flagNames(flags []*Flag) []string {
+	var names []string
+	for _, flag := range flags {
+		names = append(names, flag.Name)
+	}
+	return names
+}
+
+func TestParseStruct_SliceOfStructs(t *testing.T) {
+	t.Setenv("UPSTREAM_2_HOST", "c")
+	cfg := &struct {
+		Upstream []upstreamConfig `size:"1"`
+		Mirrors  []*upstreamConfig
+		Skipped  []upstreamConfig `env:"-"`
+	}{
+		Mirrors: []*upstreamConfig{{Host: "mirror"}},
+	}
+	flags, err := ParseStruct(cfg, DiscoverFlags("skipped-0-port"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"upstream-0-host", "upstream-0-port",
+		"upstream-1-host", "upstream-1-port",
+		"upstream-2-host", "upstream-2-port",
+		"mirrors-0-host", "mirrors-0-port",
+		"skipped-0-host", "skipped-0-port",
+	}, flagNames(flags))
+	assert.Equal(t, []string{"UPSTREAM_2_HOST"}, flags[4].EnvNames)
+	require.Len(t, cfg.Upstream, 3)
+	assert.Equal(t, upstreamConfig{Port: 80}, cfg.Upstream[1])
+	assert.Equal(t, "mirror", flags[6].DefValue)
+
+	require.NoError(t, flags[0].Value.Set("a"))
+	require.NoError(t, flags[7].Value.Set("8080"))
+	assert.Equal(t, "a", cfg.Upstream[0].Host)
+	assert.Equal(t, &upstreamConfig{Host: "mirror", Port: 8080}, cfg.Mirrors[0])
+
+	_, err = ParseStruct(&struct {
+		Upstream []upstreamConfig `size:"x"`
+	}{})
+	assert.EqualError(t, err, `field Upstream: invalid size "x"`)
+
+	_, err = ParseStruct(&struct {
+		Upstream []upstreamConfig
+	}{}, DiscoverFlags("upstream-99999999-host"))
+	assert.EqualError(t, err, "field Upstream: index 99999999 is out of range, max index is 1000")
+}
+
+func TestParseStruct_MapOfStructs(t *testing.T) {
+	t.Setenv("APP_BACKEND_EU_WEST_PORT", "8443")
+	cfg := &struct {
+		Backend map[string]upstreamConfig `keys:"primary"`
+		Named   map[string]*upstreamConfig
+	}{
+		Named: map[string]*upstreamConfig{"main": {Host: "main"}},
+	}
+	flags, err := ParseStruct(cfg, EnvPrefix("APP_"), DiscoverFlags("named-backup-host"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"backend-eu-west-host", "backend-eu-west-port",
+		"backend-primary-host", "backend-primary-port",
+		"named-backup-host", "named-backup-port",
+		"named-main-host", "named-main-port",
+	}, flagNames(flags))
+	assert.Equal(t, []string{"APP_BACKEND_PRIMARY_HOST"}, flags[2].EnvNames)
+	assert.Equal(t, upstreamConfig{Port: 80}, cfg.Backend["primary"])
+
+	require.NoError(t, flags[2].Value.Set("db"))
+	require.NoError(t, flags[3].Value.Set("5432"))
+	assert.Equal(t, upstreamConfig{Host: "db", Port: 5432}, cfg.Backend["primary"])
+	assert.EqualError(t, flags[3].Value.Set("x"), `strconv.ParseInt: parsing "x": invalid syntax`)
+	assert.Equal(t, upstreamConfig{Host: "db", Port: 5432}, cfg.Backend["primary"])
+
+	require.NoError(t, flags[4].Value.Set("backup"))
+	assert.Equal(t, &upstreamConfig{Host: "backup", Port: 80}, cfg.Named["backup"])
+	assert.Equal(t, "main", cfg.Named["main"].Host)
+}
+
+func TestParseStruct_NestedMapOfStructs(t *testing.T) {
+	type zone struct {
+		Backend map[string]upstreamConfig `keys:"a"`
+	}
+	cfg := &struct {
+		Zone map[string]zone `keys:"eu"`
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"zone-eu-backend-a-host", "zone-eu-backend-a-port"}, flagNames(flags))
+	require.NoError(t, flags[0].Value.Set("db"))
+	assert.Equal(t, "db", cfg.Zone["eu"].Backend["a"].Host)
+}
+
+type nodeConfig struct {
+	Name     string
+	Children []nodeConfig
+}
+
+func TestParseStruct_RecursiveElems(t *testing.T) {
+	t.Setenv("CHILDREN_1_NAME", "b")
+	cfg := &nodeConfig{Children: []nodeConfig{{Name: "a", Children: []nodeConfig{{}}}}}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"name",
+		"children-0-name", "children-0-children-0-name",
+		"children-1-name",
+	}, flagNames(flags))
+	require.NoError(t, flags[3].Value.Set("c"))
+	assert.Equal(t, "c", cfg.Children[1].Name)
+}
+
+func TestParseStruct_ElemKeySuffixes(t *testing.T) {
+	type backendConfig struct {
+		Port      int
+		AdminPort int
+	}
+	t.Setenv("BACKEND_PRIMARY_ADMIN_PORT", "9")
+	cfg := &struct {
+		Backend map[string]backendConfig
+	}{}
+	flags, err := ParseStruct(cfg, DiscoverFlags("backend-secondary-admin-port", "backend-eu-west-port"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"backend-eu-west-port", "backend-eu-west-admin-port",
+		"backend-primary-port", "backend-primary-admin-port",
+		"backend-secondary-port", "backend-secondary-admin-port",
+	}, flagNames(flags))
+	require.NoError(t, flags[3].Value.Set("9"))
+	assert.Equal(t, map[string]backendConfig{
+		"eu-west":   {},
+		"primary":   {AdminPort: 9},
+		"secondary": {},
+	}, cfg.Backend)
+}
@@ -0,0 +1,66 @@
+package sflags
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// envFileSuffix is appended to an environment variable name
+// to get a name of the variable with a path to a file with the value.
+const envFileSuffix = "_FILE"
+
+// LookupEnv returns name and value of the first non empty
+// environment variable from flag.EnvNames.
+func LookupEnv(flag *Flag) (name, value string, found bool) {
+	for _, envName := range flag.EnvNames {
+		if value, ok := os.LookupEnv(envName); ok && value != "" {
+			return envName, value, true
+		}
+	}
+	return "", "", false
+}
+
+// EnvUsage returns a hint about flag.EnvNames for help message,
+// e.g. "[$HTTP_HOST, $HOST]". It's empty if flag has no environment variables.
+func EnvUsage(flag *Flag) string {
+	if len(flag.EnvNames) == 0 {
+		return ""
+	}
+	return "[$" + strings.Join(flag.EnvNames, ", $") + "]"
+}
+
+// LookupEnvFile returns name of the first non empty "<ENV>_FILE" variable
+// for flag.EnvNames and trimmed contents of the file it points to,
+// if flag.EnvFile is set. Generators use it, when no variable
+// from flag.EnvNames is set, so the file takes precedence over config files,
+// but not over environment variables and command line arguments.
+func LookupEnvFile(flag *Flag) (name, value string, found bool, err error) {
+	if !flag.EnvFile {
+		return "", "", false, nil
+	}
+	return lookupEnvFile(flag.EnvNames)
+}
+
+// lookupEnvFile returns name of the first non empty "<ENV>_FILE" variable
+// for envNames and trimmed contents of the file it points to.
+// Nothing is returned if any of envNames is set itself, because it takes precedence.
+func lookupEnvFile(envNames []string) (name, value string, found bool, err error) {
+	for _, envName := range envNames {
+		if value, ok := os.LookupEnv(envName); ok && value != "" {
+			return "", "", false, nil
+		}
+	}
+	for _, envName := range envNames {
+		path := os.Getenv(envName + envFileSuffix)
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", "", false, fmt.Errorf("can't read %s: %w", envName+envFileSuffix, err)
+		}
+		return envName + envFileSuffix, strings.TrimSpace(string(data)), true, nil
+	}
+	return "", "", false, nil
+}
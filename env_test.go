@@ -0,0 +1,72 @@
+package sflags
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupEnv(t *testing.T) {
+	flag := &Flag{EnvNames: []string{"SFLAGS_TEST_ONE", "SFLAGS_TEST_TWO"}}
+	_, _, found := LookupEnv(flag)
+	assert.False(t, found)
+
+	t.Setenv("SFLAGS_TEST_ONE", "")
+	t.Setenv("SFLAGS_TEST_TWO", "two")
+	name, value, found := LookupEnv(flag)
+	assert.True(t, found)
+	assert.Equal(t, "SFLAGS_TEST_TWO", name)
+	assert.Equal(t, "two", value)
+
+	t.Setenv("SFLAGS_TEST_ONE", "one")
+	name, value, found = LookupEnv(flag)
+	assert.True(t, found)
+	assert.Equal(t, "SFLAGS_TEST_ONE", name)
+	assert.Equal(t, "one", value)
+}
+
+func TestEnvUsage(t *testing.T) {
+	assert.Equal(t, "", EnvUsage(&Flag{}))
+	assert.Equal(t, "[$HOST]", EnvUsage(&Flag{EnvNames: []string{"HOST"}}))
+	assert.Equal(t, "[$HTTP_HOST, $HOST]", EnvUsage(&Flag{EnvNames: []string{"HTTP_HOST", "HOST"}}))
+}
+
+func TestLookupEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(path, []byte("  qwerty\n"), 0o600))
+	envNames := []string{"SFLAGS_TEST_ONE", "SFLAGS_TEST_TWO"}
+
+	_, _, found, err := lookupEnvFile(envNames)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	t.Setenv("SFLAGS_TEST_TWO_FILE", path)
+	name, value, found, err := lookupEnvFile(envNames)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "SFLAGS_TEST_TWO_FILE", name)
+	assert.Equal(t, "qwerty", value)
+
+	t.Setenv("SFLAGS_TEST_ONE", "value")
+	_, _, found, err = lookupEnvFile(envNames)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	t.Setenv("SFLAGS_TEST_ONE", "")
+	t.Setenv("SFLAGS_TEST_ONE_FILE", path+".missing")
+	_, _, _, err = lookupEnvFile(envNames)
+	assert.EqualError(t, err, "can't read SFLAGS_TEST_ONE_FILE: open "+path+".missing: no such file or directory")
+
+	t.Setenv("SFLAGS_TEST_ONE_FILE", "")
+	_, _, found, err = LookupEnvFile(&Flag{EnvNames: envNames})
+	require.NoError(t, err)
+	assert.False(t, found)
+	name, value, found, err = LookupEnvFile(&Flag{EnvNames: envNames, EnvFile: true})
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "SFLAGS_TEST_TWO_FILE", name)
+	assert.Equal(t, "qwerty", value)
+}
@@ -0,0 +1,211 @@
+package sflags
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// ExistingFile is a path to an existing readable file.
+// It's checked when the value is set.
+type ExistingFile string
+
+// ExistingDir is a path to an existing readable directory.
+// It's checked when the value is set.
+type ExistingDir string
+
+// FileWrite is a File.Flag value to create or truncate a file for writing.
+const FileWrite = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+
+// File is a path to a file, that is opened lazily by Open method.
+// By default a file is opened for reading, set Flag (e.g. to FileWrite)
+// in a default value of a structure to open it for writing.
+// "-" means stdin for reading and stdout for writing.
+//
+// The file is checked when the value is set: it must exist and be readable
+// to be opened for reading, or its directory must exist to be created.
+// Slices of File are always opened for reading.
+type File struct {
+	Path string
+	Flag int         // flag for os.OpenFile, os.O_RDONLY by default
+	Perm os.FileMode // permissions of a created file, 0666 by default
+
+	file *os.File
+}
+
+// String returns the path of File.
+func (f File) String() string { return f.Path }
+
+// Open opens the file on the first call and returns it on subsequent ones.
+func (f *File) Open() (*os.File, error) {
+	if f.file != nil {
+		return f.file, nil
+	}
+	if f.Path == "-" {
+		if f.isWrite() {
+			f.file = os.Stdout
+		} else {
+			f.file = os.Stdin
+		}
+		return f.file, nil
+	}
+	perm := f.Perm
+	if perm == 0 {
+		perm = 0o666
+	}
+	file, err := os.OpenFile(f.Path, f.Flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	f.file = file
+	return file, nil
+}
+
+// Close closes the file if it was opened. Stdin and stdout are kept open.
+func (f *File) Close() error {
+	file := f.file
+	f.file = nil
+	if file == nil || file == os.Stdin || file == os.Stdout {
+		return nil
+	}
+	return file.Close()
+}
+
+func (f *File) isWrite() bool {
+	return f.Flag&(os.O_WRONLY|os.O_RDWR) != 0
+}
+
+// check checks that the file might be opened with f.Flag.
+func (f *File) check() error {
+	if f.Path == "-" {
+		return nil
+	}
+	info, err := os.Stat(f.Path)
+	switch {
+	case err == nil && info.IsDir():
+		return fmt.Errorf("%q is a directory", f.Path)
+	case err == nil && f.isWrite():
+		return checkOpen(f.Path, os.O_WRONLY)
+	case err == nil:
+		return checkOpen(f.Path, os.O_RDONLY)
+	case errors.Is(err, os.ErrNotExist) && f.Flag&os.O_CREATE != 0:
+		_, err = parseExistingDir(filepath.Dir(f.Path))
+		return err
+	default:
+		return err
+	}
+}
+
+// FileCloser returns io.Closer, that closes all files
+// opened by File values of flags. Call it at shutdown.
+func FileCloser(flags []*Flag) io.Closer {
+	return fileCloser(flags)
+}
+
+type fileCloser []*Flag
+
+func (c fileCloser) Close() error {
+	var errs []error
+	for _, flag := range c {
+		switch v := unwrapValue(flag.Value).(type) {
+		case *fileValue:
+			errs = append(errs, v.value.Close())
+		case *fileSliceValue:
+			for i := range *v.value {
+				errs = append(errs, (*v.value)[i].Close())
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// -- File Value
+
+// fileValue keeps Flag and Perm of File, that generated values would reset.
+type fileValue struct {
+	value *File
+}
+
+var _ Value = (*fileValue)(nil)
+var _ Getter = (*fileValue)(nil)
+
+func newFileValue(p *File) *fileValue {
+	return &fileValue{value: p}
+}
+
+func (v *fileValue) Set(s string) error {
+	file := File{Path: s, Flag: v.value.Flag, Perm: v.value.Perm}
+	if err := file.check(); err != nil {
+		return err
+	}
+	if err := v.value.Close(); err != nil {
+		return err
+	}
+	*v.value = file
+	return nil
+}
+
+func (v *fileValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *fileValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *fileValue) Type() string { return "file" }
+
+// === Parsers
+
+func parseFile(s string) (File, error) {
+	file := File{Path: s}
+	if err := file.check(); err != nil {
+		return File{}, err
+	}
+	return file, nil
+}
+
+func parseExistingFile(s string) (ExistingFile, error) {
+	info, err := os.Stat(s)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%q is a directory", s)
+	}
+	if err := checkOpen(s, os.O_RDONLY); err != nil {
+		return "", err
+	}
+	return ExistingFile(s), nil
+}
+
+func parseExistingDir(s string) (ExistingDir, error) {
+	info, err := os.Stat(s)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%q is not a directory", s)
+	}
+	if err := checkOpen(s, os.O_RDONLY); err != nil {
+		return "", err
+	}
+	return ExistingDir(s), nil
+}
+
+// checkOpen checks permissions by opening path and closing it right away.
+func checkOpen(path string, flag int) error {
+	f, err := os.OpenFile(path, flag, 0)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
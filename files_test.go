@@ -0,0 +1,79 @@
+package sflags
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_Write(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &struct {
+		Out   File
+		Input []File
+		Dir   ExistingDir
+	}{
+		Out: File{Path: "-", Flag: FileWrite, Perm: 0o600},
+	}
+	flags, err := ParseStruct(cfg, TrackSource())
+	require.NoError(t, err)
+	require.Len(t, flags, 3)
+	assert.Equal(t, "-", flags[0].DefValue)
+
+	out, err := cfg.Out.Open()
+	require.NoError(t, err)
+	assert.Equal(t, os.Stdout, out)
+
+	assert.EqualError(t, flags[0].Value.Set(filepath.Join(dir, "nodir", "out.txt")),
+		"stat "+filepath.Join(dir, "nodir")+": no such file or directory")
+	assert.EqualError(t, flags[0].Value.Set(dir), `"`+dir+`" is a directory`)
+
+	path := filepath.Join(dir, "out.txt")
+	require.NoError(t, flags[0].Value.Set(path))
+	assert.Equal(t, File{Path: path, Flag: FileWrite, Perm: 0o600}, cfg.Out)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "file must be created lazily")
+
+	out, err = cfg.Out.Open()
+	require.NoError(t, err)
+	_, err = out.WriteString("data")
+	require.NoError(t, err)
+	again, err := cfg.Out.Open()
+	require.NoError(t, err)
+	assert.Equal(t, out, again)
+
+	require.NoError(t, flags[1].Value.Set(path+",-"))
+	require.Len(t, cfg.Input, 2)
+	in, err := cfg.Input[1].Open()
+	require.NoError(t, err)
+	assert.Equal(t, os.Stdin, in)
+	in, err = cfg.Input[0].Open()
+	require.NoError(t, err)
+
+	require.NoError(t, FileCloser(flags).Close())
+	assert.Error(t, out.Close(), "file must be closed")
+	assert.Error(t, in.Close(), "file must be closed")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(content))
+}
+
+func TestFile_Set(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "in.txt")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+
+	file := File{}
+	v := newFileValue(&file)
+	require.NoError(t, v.Set(path))
+	f, err := file.Open()
+	require.NoError(t, err)
+
+	// opened file is closed, when another one is set
+	require.NoError(t, v.Set("-"))
+	assert.Error(t, f.Close(), "file must be closed")
+	assert.Equal(t, File{Path: "-"}, file)
+}
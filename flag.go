@@ -3,13 +3,32 @@ package sflags
 
 // Flag structure might be used by cli/flag libraries for their flag generation.
 type Flag struct {
-	Name       string // name as it appears on command line
-	Short      string // optional short name
-	EnvNames   []string
-	Usage      string // help message
-	Value      Value  // value as set
-	DefValue   string // default value (as text); for usage message
-	Hidden     bool
-	Deprecated bool
-	Required   bool
+	Name        string // name as it appears on command line
+	Short       string // optional short name
+	EnvNames    []string
+	EnvFile     bool   // value might be read from a file set by "<ENV>_FILE" variable, see LookupEnvFile
+	Usage       string // help message
+	Value       Value  // value as set
+	DefValue    string // default value (as text); for usage message
+	Hidden      bool
+	Deprecated  bool
+	Required    bool
+	Secret      bool     // value is masked in Dump
+	Choices     []string // optional list of allowed values
+	Placeholder string   // optional name of the value in help message, e.g. "HOST"
+	Groups      []Group  // groups of mutually exclusive or co-required flags
+	Negatable   bool     // boolean flag has --no-<name> counterpart, see Negation
+
+	seps *separators // separators of slice and map values, nil means default ones
+}
+
+// Arg structure describes a positional argument,
+// it might be used by cli/flag libraries for their argument generation.
+type Arg struct {
+	Name     string // name as it appears in help message
+	Usage    string // help message
+	Value    Value  // value as set
+	DefValue string // default value (as text); for usage message
+	Required bool
+	Variadic bool // takes all remaining arguments, might be only the last one
 }
@@ -1,6 +1,10 @@
 package gcli
 
 import (
+	"flag"
+	"fmt"
+	"strings"
+
 	"github.com/urfave/cli/v2"
 	"github.com/urfave/sflags"
 )
@@ -8,22 +12,96 @@ import (
 // GenerateTo takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
 func GenerateTo(src []*sflags.Flag, dst *[]cli.Flag) {
-	for _, srcFlag := range src {
+	for _, srcFlag := range withNegations(src) {
 		name := srcFlag.Name
 		var aliases []string
 		if srcFlag.Short != "" {
 			aliases = append(aliases, srcFlag.Short)
 		}
-		*dst = append(*dst, &cli.GenericFlag{
+		flag := &cli.GenericFlag{
 			Name:     name,
 			EnvVars:  srcFlag.EnvNames,
 			Aliases:  aliases,
 			Hidden:   srcFlag.Hidden,
-			Usage:    srcFlag.Usage,
+			Usage:    usage(srcFlag),
 			Value:    srcFlag.Value,
 			Required: srcFlag.Required,
-		})
+		}
+		if len(srcFlag.EnvNames) > 0 {
+			*dst = append(*dst, &envFlag{GenericFlag: flag, src: srcFlag})
+			continue
+		}
+		*dst = append(*dst, flag)
+	}
+}
+
+// envFlag records an environment variable as the source of a value,
+// that urfave/cli sets from it, and sets a value from "<ENV>_FILE" variable,
+// see sflags.LookupEnvFile, when urfave/cli doesn't find environment variables of the flag.
+type envFlag struct {
+	*cli.GenericFlag
+	src *sflags.Flag
+}
+
+// Apply puts the flag to set like cli.GenericFlag does.
+// It's called before command line arguments are parsed.
+func (f *envFlag) Apply(set *flag.FlagSet) error {
+	if err := f.GenericFlag.Apply(set); err != nil {
+		return err
+	}
+	if f.HasBeenSet {
+		if envName, _, found := sflags.LookupEnv(f.src); found {
+			f.src.SetSource(sflags.Source{Kind: sflags.SourceEnv, Name: envName})
+		}
+		return nil
+	}
+	envName, value, found, err := sflags.LookupEnvFile(f.src)
+	if err != nil || !found {
+		return err
+	}
+	if err := f.src.SetFrom(value, sflags.Source{Kind: sflags.SourceEnv, Name: envName}); err != nil {
+		return fmt.Errorf("could not parse value from %s for flag %s: %w", envName, f.Name, err)
+	}
+	// the flag is set, so a required one is satisfied by the file.
+	f.HasBeenSet = true
+	return nil
+}
+
+// withNegations returns src with hidden --no-<name> flags
+// after negatable ones, see sflags.Flag.Negation.
+func withNegations(src []*sflags.Flag) []*sflags.Flag {
+	out := make([]*sflags.Flag, 0, len(src))
+	for _, srcFlag := range src {
+		out = append(out, srcFlag)
+		if negation := srcFlag.Negation(); negation != nil {
+			out = append(out, negation)
+		}
 	}
+	return out
+}
+
+// usage returns help message for srcFlag with back-quoted placeholder,
+// allowed values and negation appended.
+func usage(srcFlag *sflags.Flag) string {
+	return sflags.NegationUsage(srcFlag, sflags.ChoicesUsage(srcFlag, sflags.QuotedUsage(srcFlag)))
+}
+
+// GenerateArgsUsage takes a list of sflag.Arg,
+// that are parsed from some config structure, and returns usage text for them.
+// It might be used as ArgsUsage for cli.App or cli.Command.
+func GenerateArgsUsage(src []*sflags.Arg) string {
+	usages := make([]string, 0, len(src))
+	for _, srcArg := range src {
+		usages = append(usages, argUsage(srcArg))
+	}
+	return strings.Join(usages, " ")
+}
+
+// SetArgs takes a list of sflag.Arg,
+// that are parsed from some config structure, and sets them from src arguments.
+// urfave/cli v2 doesn't support positional arguments, so call it from Before or Action.
+func SetArgs(dst []*sflags.Arg, src *cli.Context) error {
+	return sflags.SetArgs(dst, src.Args().Slice())
 }
 
 // ParseTo parses cfg, that is a pointer to some structure,
@@ -47,3 +125,57 @@ func Parse(cfg interface{}, optFuncs ...sflags.OptFunc) ([]cli.Flag, error) {
 	}
 	return flags, nil
 }
+
+// GenerateCommandsTo takes a list of sflags.Command,
+// that are parsed from some config structure, and puts them to dst.
+// Positional arguments of a command are set before its action is run.
+func GenerateCommandsTo(src []*sflags.Command, dst *[]*cli.Command) {
+	for _, srcCmd := range src {
+		srcCmd := srcCmd
+		cmd := &cli.Command{
+			Name:      srcCmd.Name,
+			Usage:     srcCmd.Usage,
+			Hidden:    srcCmd.Hidden,
+			ArgsUsage: GenerateArgsUsage(srcCmd.Args),
+			Before: func(c *cli.Context) error {
+				srcCmd.Select()
+				if len(srcCmd.Args) == 0 {
+					return nil
+				}
+				return SetArgs(srcCmd.Args, c)
+			},
+		}
+		GenerateTo(srcCmd.Flags, &cmd.Flags)
+		GenerateCommandsTo(srcCmd.Commands, &cmd.Subcommands)
+		*dst = append(*dst, cmd)
+	}
+}
+
+// ParseCommandTo parses cfg, that is a pointer to some structure,
+// puts its flags and subcommands to dst and returns parsed command tree.
+// Flags of the root structure are global flags of dst.
+// Use Selected method of returned command to find out which subcommand was run.
+func ParseCommandTo(cfg interface{}, dst *cli.App, optFuncs ...sflags.OptFunc) (*sflags.Command, error) {
+	cmd, err := sflags.ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	GenerateTo(cmd.Flags, &dst.Flags)
+	if len(cmd.Args) > 0 {
+		dst.ArgsUsage = GenerateArgsUsage(cmd.Args)
+	}
+	GenerateCommandsTo(cmd.Commands, &dst.Commands)
+	return cmd, nil
+}
+
+// argUsage returns usage text for srcArg, e.g. "src", "[dst]" or "[files...]".
+func argUsage(srcArg *sflags.Arg) string {
+	name := srcArg.Name
+	if srcArg.Variadic {
+		name += "..."
+	}
+	if !srcArg.Required {
+		name = "[" + name + "]"
+	}
+	return name
+}
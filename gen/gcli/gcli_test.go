@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -164,3 +166,138 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestSetArgs(t *testing.T) {
+	cfg := &struct {
+		Verbose bool
+		Src     string   `arg:",required"`
+		Files   []string `arg:""`
+	}{}
+	flags, args, err := sflags.ParseStructWithArgs(cfg)
+	require.NoError(t, err)
+	cliApp := cli.NewApp()
+	GenerateTo(flags, &cliApp.Flags)
+	cliApp.ArgsUsage = GenerateArgsUsage(args)
+	assert.Equal(t, "src [files...]", cliApp.ArgsUsage)
+	cliApp.Action = func(c *cli.Context) error {
+		return SetArgs(args, c)
+	}
+	err = cliApp.Run([]string{"cliApp", "--verbose", "in", "a", "b"})
+	require.NoError(t, err)
+	assert.True(t, cfg.Verbose)
+	assert.Equal(t, "in", cfg.Src)
+	assert.Equal(t, []string{"a", "b"}, cfg.Files)
+}
+
+type serveCmd struct {
+	Port int    `default:"8080"`
+	Dir  string `arg:",required"`
+}
+
+type migrateUpCmd struct {
+	Steps int
+}
+
+type migrateCmd struct {
+	DryRun bool
+	Up     migrateUpCmd `cmd:"up"`
+}
+
+type rootCmd struct {
+	Verbose bool
+	Serve   serveCmd   `cmd:"serve" desc:"start server"`
+	Migrate migrateCmd `cmd:"migrate"`
+}
+
+func TestParseCommandTo(t *testing.T) {
+	cfg := &rootCmd{}
+	cliApp := cli.NewApp()
+	cmd, err := ParseCommandTo(cfg, cliApp)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(cliApp.Commands))
+	assert.Equal(t, "start server", cliApp.Commands[0].Usage)
+	assert.Equal(t, "dir", cliApp.Commands[0].ArgsUsage)
+	cliApp.Commands[0].Action = func(*cli.Context) error { return nil }
+	cliApp.Commands[1].Subcommands[0].Action = func(*cli.Context) error { return nil }
+
+	err = cliApp.Run([]string{"cliApp", "--verbose", "migrate", "--dry-run", "up", "--steps", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, "up", cmd.Selected().Name)
+	assert.Equal(t, &rootCmd{
+		Verbose: true,
+		Serve:   serveCmd{Port: 8080},
+		Migrate: migrateCmd{DryRun: true, Up: migrateUpCmd{Steps: 3}},
+	}, cfg)
+
+	err = cliApp.Run([]string{"cliApp", "serve", "--port", "9000", "/tmp"})
+	require.NoError(t, err)
+	assert.Equal(t, 9000, cfg.Serve.Port)
+	assert.Equal(t, "/tmp", cfg.Serve.Dir)
+}
+
+func TestPlaceholder(t *testing.T) {
+	cfg := &struct {
+		Host   string `placeholder:"HOST" desc:"http host"`
+		Output string `desc:"write result to \x60FILE\x60"`
+	}{}
+	flags, err := Parse(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(flags))
+	assert.Equal(t, "--host HOST\tHOST http host [$HOST]", flags[0].String())
+	assert.Equal(t, "--output FILE\twrite result to FILE [$OUTPUT]", flags[1].String())
+}
+
+func TestEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(path, []byte("qwerty\n"), 0o600))
+	t.Setenv("DB_PASSWORD_FILE", path)
+
+	run := func(args ...string) string {
+		cfg := &struct {
+			DBPassword string `flag:"db-password,required" env:"DB_PASSWORD"`
+		}{}
+		cliApp := cli.NewApp()
+		cliApp.Writer = io.Discard
+		require.NoError(t, ParseTo(cfg, &cliApp.Flags, sflags.EnvFile()))
+		require.NoError(t, cliApp.Run(append([]string{"cliApp"}, args...)))
+		return cfg.DBPassword
+	}
+	assert.Equal(t, "qwerty", run())
+	assert.Equal(t, "secret", run("--db-password", "secret"))
+	t.Setenv("DB_PASSWORD", "env-secret")
+	assert.Equal(t, "env-secret", run())
+}
+
+func TestNegatable(t *testing.T) {
+	cfg := &struct {
+		Color bool `flag:",negatable"`
+	}{Color: true}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	cliApp := cli.NewApp()
+	GenerateTo(flags, &cliApp.Flags)
+	require.Len(t, cliApp.Flags, 2)
+	assert.Equal(t, []string{"no-color"}, cliApp.Flags[1].Names())
+	assert.True(t, cliApp.Flags[1].(*cli.GenericFlag).Hidden)
+	assert.Equal(t, "(use --no-color to disable)", cliApp.Flags[0].(cli.DocGenerationFlag).GetUsage())
+
+	err = cliApp.Run([]string{"cliApp", "--no-color"})
+	require.NoError(t, err)
+	assert.False(t, cfg.Color)
+}
+
+func TestEnvSource(t *testing.T) {
+	t.Setenv("PORT", "9000")
+	cfg := &struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT"`
+	}{}
+	flags, err := sflags.ParseStruct(cfg, sflags.TrackSource())
+	require.NoError(t, err)
+	cliApp := cli.NewApp()
+	GenerateTo(flags, &cliApp.Flags)
+	require.NoError(t, cliApp.Run([]string{"cliApp", "--host", "localhost"}))
+	assert.Equal(t, 9000, cfg.Port)
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceCLI}, flags[0].Source())
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceEnv, Name: "PORT"}, flags[1].Source())
+}
@@ -1,6 +1,10 @@
 package gcli
 
 import (
+	"context"
+	"flag"
+	"fmt"
+
 	"github.com/urfave/cli/v3"
 	"github.com/urfave/sflags"
 )
@@ -30,27 +34,139 @@ func (v value) IsBoolFlag() bool {
 	return ok && b.IsBoolFlag()
 }
 
+type argument struct {
+	arg *sflags.Arg
+}
+
+func (a argument) Usage() string {
+	return argUsage(a.arg)
+}
+
+func (a argument) Parse(s []string) ([]string, error) {
+	n := 1
+	if a.arg.Variadic || len(s) == 0 {
+		n = len(s)
+	}
+	err := sflags.SetArgs([]*sflags.Arg{a.arg}, s[:n])
+	if err != nil {
+		return s, err
+	}
+	return s[n:], nil
+}
+
 // GenerateToV3 takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
 func GenerateToV3(src []*sflags.Flag, dst *[]cli.Flag) {
-	for _, srcFlag := range src {
+	for _, srcFlag := range withNegations(src) {
 		name := srcFlag.Name
 		var aliases []string
 		if srcFlag.Short != "" {
 			aliases = append(aliases, srcFlag.Short)
 		}
-		*dst = append(*dst, &cli.GenericFlag{
+		flag := &cli.GenericFlag{
 			Name:    name,
 			Sources: cli.EnvVars(srcFlag.EnvNames...),
 			Aliases: aliases,
 			Hidden:  srcFlag.Hidden,
-			Usage:   srcFlag.Usage,
+			Usage:   usage(srcFlag),
 			Value: &value{
 				v: srcFlag.Value,
 			},
 			Required: srcFlag.Required,
-		})
+		}
+		if len(srcFlag.EnvNames) > 0 {
+			*dst = append(*dst, &envFlagV3{GenericFlag: flag, src: srcFlag})
+			continue
+		}
+		*dst = append(*dst, flag)
+	}
+}
+
+// envFlagV3 records an environment variable as the source of a value,
+// that urfave/cli sets from it, and sets a value from "<ENV>_FILE" variable,
+// see sflags.LookupEnvFile, when urfave/cli doesn't find environment variables of the flag.
+type envFlagV3 struct {
+	*cli.GenericFlag
+	src *sflags.Flag
+	// flags are applied to flag sets of every subcommand,
+	// but environment is looked up only the first time.
+	applied  bool
+	fromFile bool
+}
+
+// Apply puts the flag to set like cli.GenericFlag does.
+// It's called before command line arguments are parsed.
+func (f *envFlagV3) Apply(set *flag.FlagSet) error {
+	applied := f.applied
+	f.applied = true
+	if err := f.GenericFlag.Apply(set); err != nil || applied {
+		return err
+	}
+	if f.GenericFlag.IsSet() {
+		if envName, _, found := sflags.LookupEnv(f.src); found {
+			f.src.SetSource(sflags.Source{Kind: sflags.SourceEnv, Name: envName})
+		}
+		return nil
+	}
+	envName, value, found, err := sflags.LookupEnvFile(f.src)
+	if err != nil || !found {
+		return err
+	}
+	if err := f.src.SetFrom(value, sflags.Source{Kind: sflags.SourceEnv, Name: envName}); err != nil {
+		return fmt.Errorf("could not parse value from %s for flag %s: %w", envName, f.Name, err)
+	}
+	f.fromFile = true
+	return nil
+}
+
+// IsSet returns whether the flag has been set from command line,
+// environment variable or file, so a required one is satisfied by the file.
+func (f *envFlagV3) IsSet() bool {
+	return f.fromFile || f.GenericFlag.IsSet()
+}
+
+// GenerateArgsToV3 takes a list of sflag.Arg,
+// that are parsed from some config structure, and put it to dst.
+func GenerateArgsToV3(src []*sflags.Arg, dst *[]cli.Argument) {
+	for _, srcArg := range src {
+		*dst = append(*dst, &argument{arg: srcArg})
+	}
+}
+
+// GenerateCommandsToV3 takes a list of sflags.Command,
+// that are parsed from some config structure, and puts them to dst.
+func GenerateCommandsToV3(src []*sflags.Command, dst *[]*cli.Command) {
+	for _, srcCmd := range src {
+		srcCmd := srcCmd
+		cmd := &cli.Command{
+			Name:   srcCmd.Name,
+			Usage:  srcCmd.Usage,
+			Hidden: srcCmd.Hidden,
+			Before: func(ctx context.Context, _ *cli.Command) (context.Context, error) {
+				srcCmd.Select()
+				return ctx, nil
+			},
+		}
+		GenerateToV3(srcCmd.Flags, &cmd.Flags)
+		GenerateArgsToV3(srcCmd.Args, &cmd.Arguments)
+		GenerateCommandsToV3(srcCmd.Commands, &cmd.Commands)
+		*dst = append(*dst, cmd)
+	}
+}
+
+// ParseCommandToV3 parses cfg, that is a pointer to some structure,
+// puts its flags, positional arguments and subcommands to dst and returns parsed command tree.
+// Flags are persistent in urfave/cli v3, so subcommands accept flags of their parents.
+// Use Selected method of returned command to find out which subcommand was run.
+func ParseCommandToV3(cfg interface{}, dst *cli.Command, optFuncs ...sflags.OptFunc) (*sflags.Command, error) {
+	cmd, err := sflags.ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
 	}
+	GenerateToV3(cmd.Flags, &dst.Flags)
+	GenerateArgsToV3(cmd.Args, &dst.Arguments)
+	GenerateCommandsToV3(cmd.Commands, &dst.Commands)
+	return cmd, nil
 }
 
 // ParseToV3 parses cfg, that is a pointer to some structure,
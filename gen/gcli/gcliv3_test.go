@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -165,3 +167,137 @@ func TestParseV3(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateArgsToV3(t *testing.T) {
+	cfg := &struct {
+		Verbose bool
+		Src     string   `arg:",required"`
+		Dst     string   `arg:""`
+		Files   []string `arg:""`
+	}{}
+	flags, args, err := sflags.ParseStructWithArgs(cfg)
+	require.NoError(t, err)
+	cmd := &cli.Command{}
+	GenerateToV3(flags, &cmd.Flags)
+	GenerateArgsToV3(args, &cmd.Arguments)
+	require.Equal(t, 3, len(cmd.Arguments))
+	assert.Equal(t, "src", cmd.Arguments[0].Usage())
+	assert.Equal(t, "[dst]", cmd.Arguments[1].Usage())
+	assert.Equal(t, "[files...]", cmd.Arguments[2].Usage())
+	cmd.Action = func(_ context.Context, c *cli.Command) error {
+		return nil
+	}
+	err = cmd.Run(context.Background(), []string{"cliApp", "--verbose", "in", "out", "a", "b"})
+	require.NoError(t, err)
+	assert.True(t, cfg.Verbose)
+	assert.Equal(t, "in", cfg.Src)
+	assert.Equal(t, "out", cfg.Dst)
+	assert.Equal(t, []string{"a", "b"}, cfg.Files)
+
+	err = cmd.Run(context.Background(), []string{"cliApp"})
+	require.EqualError(t, err, "required argument src not provided")
+}
+
+type rootCmdV3 struct {
+	Verbose bool
+	Serve   struct {
+		Port int    `default:"8080"`
+		Dir  string `arg:",required"`
+	} `cmd:"serve" desc:"start server"`
+	Migrate struct {
+		DryRun bool
+		Up     struct {
+			Steps int
+		} `cmd:"up"`
+	} `cmd:"migrate"`
+}
+
+func TestParseCommandToV3(t *testing.T) {
+	cfg := &rootCmdV3{}
+	root := &cli.Command{}
+	cmd, err := ParseCommandToV3(cfg, root)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(root.Commands))
+	assert.Equal(t, "start server", root.Commands[0].Usage)
+	action := func(context.Context, *cli.Command) error { return nil }
+	root.Commands[0].Action = action
+	root.Commands[1].Commands[0].Action = action
+
+	err = root.Run(context.Background(), []string{"cliApp", "migrate", "up", "--verbose", "--dry-run", "--steps", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, "up", cmd.Selected().Name)
+	assert.True(t, cfg.Verbose)
+	assert.True(t, cfg.Migrate.DryRun)
+	assert.Equal(t, 3, cfg.Migrate.Up.Steps)
+
+	err = root.Run(context.Background(), []string{"cliApp", "serve", "--port", "9000", "/tmp"})
+	require.NoError(t, err)
+	assert.Equal(t, 9000, cfg.Serve.Port)
+	assert.Equal(t, "/tmp", cfg.Serve.Dir)
+}
+
+func TestPlaceholderV3(t *testing.T) {
+	cfg := &struct {
+		Host   string `placeholder:"HOST" desc:"http host"`
+		Output string `desc:"write result to \x60FILE\x60"`
+	}{}
+	flags, err := ParseV3(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(flags))
+	assert.Equal(t, "--host HOST\tHOST http host [$HOST]", flags[0].String())
+	assert.Equal(t, "--output FILE\twrite result to FILE [$OUTPUT]", flags[1].String())
+}
+
+func TestEnvFileV3(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(path, []byte("qwerty\n"), 0o600))
+	t.Setenv("DB_PASSWORD_FILE", path)
+
+	run := func(args ...string) (string, sflags.Source) {
+		cfg := &struct {
+			DBPassword string `flag:"db-password,required" env:"DB_PASSWORD"`
+		}{}
+		flags, err := sflags.ParseStruct(cfg, sflags.EnvFile(), sflags.TrackSource())
+		require.NoError(t, err)
+		cmd := &cli.Command{Writer: io.Discard}
+		GenerateToV3(flags, &cmd.Flags)
+		require.NoError(t, cmd.Run(context.Background(), append([]string{"cliApp"}, args...)))
+		return cfg.DBPassword, flags[0].Source()
+	}
+	password, src := run()
+	assert.Equal(t, "qwerty", password)
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceEnv, Name: "DB_PASSWORD_FILE"}, src)
+	password, _ = run("--db-password", "secret")
+	assert.Equal(t, "secret", password)
+	t.Setenv("DB_PASSWORD", "env-secret")
+	password, _ = run()
+	assert.Equal(t, "env-secret", password)
+}
+
+func TestEnvSourceV3(t *testing.T) {
+	t.Setenv("PORT", "9000")
+	cfg := &struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT"`
+	}{}
+	flags, err := sflags.ParseStruct(cfg, sflags.TrackSource())
+	require.NoError(t, err)
+	cmd := &cli.Command{}
+	GenerateToV3(flags, &cmd.Flags)
+	require.NoError(t, cmd.Run(context.Background(), []string{"cliApp", "--host", "localhost"}))
+	assert.Equal(t, 9000, cfg.Port)
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceCLI}, flags[0].Source())
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceEnv, Name: "PORT"}, flags[1].Source())
+}
+
+func TestValueV3(t *testing.T) {
+	cfg := &struct {
+		Host string `env:"HOST"`
+	}{}
+	flags, err := sflags.ParseStruct(cfg, sflags.TrackSource())
+	require.NoError(t, err)
+	cmd := &cli.Command{}
+	GenerateToV3(flags, &cmd.Flags)
+	require.NoError(t, cmd.Run(context.Background(), []string{"cliApp", "--host", "localhost"}))
+	assert.Equal(t, flags[0].Value, cmd.Value("host"))
+}
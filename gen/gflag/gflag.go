@@ -2,7 +2,9 @@ package gflag
 
 import (
 	"flag"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/urfave/sflags"
 )
@@ -15,12 +17,171 @@ type flagSet interface {
 
 var _ flagSet = (*flag.FlagSet)(nil)
 
+// argsGetter describes interface,
+// that's implemented by flag library and required to set positional arguments.
+type argsGetter interface {
+	Args() []string
+}
+
+var _ argsGetter = (*flag.FlagSet)(nil)
+
+// visitor describes interface,
+// that's implemented by flag library and required to find out which flags were set.
+type visitor interface {
+	Visit(fn func(*flag.Flag))
+}
+
+var _ visitor = (*flag.FlagSet)(nil)
+
+// envFlagSet describes interface,
+// that's implemented by flag library and required to set flags from environment.
+type envFlagSet interface {
+	visitor
+	Set(name, value string) error
+}
+
+var _ envFlagSet = (*flag.FlagSet)(nil)
+
 // GenerateTo takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
 func GenerateTo(src []*sflags.Flag, dst flagSet) {
+	generateTo(src, dst, false)
+}
+
+// GenerateWithEnvTo works like GenerateTo, but also appends
+// environment variables hints, e.g. "[$HTTP_HOST]", to usage.
+// Call ParseEnv after dst is parsed to apply environment variables.
+func GenerateWithEnvTo(src []*sflags.Flag, dst flagSet) {
+	generateTo(src, dst, true)
+}
+
+func generateTo(src []*sflags.Flag, dst flagSet, withEnv bool) {
+	for _, srcFlag := range src {
+		dst.Var(srcFlag.Value, srcFlag.Name, usage(srcFlag, withEnv))
+		if negation := srcFlag.Negation(); negation != nil {
+			dst.Var(negation.Value, negation.Name, usage(negation, false))
+		}
+	}
+}
+
+// PrintDefaults works like flag.FlagSet.PrintDefaults, but skips negations
+// of negatable flags from src, that are mentioned in usage of the flags.
+// flag library can't hide flags, so default help of dst lists negations too,
+// call it from custom Usage of dst, e.g. flag.Usage for flag.CommandLine.
+func PrintDefaults(src []*sflags.Flag, dst *flag.FlagSet) {
+	negations := map[string]bool{}
+	for _, srcFlag := range src {
+		if negation := srcFlag.Negation(); negation != nil {
+			negations[negation.Name] = true
+		}
+	}
+	shown := flag.NewFlagSet(dst.Name(), flag.ContinueOnError)
+	shown.SetOutput(dst.Output())
+	dst.VisitAll(func(f *flag.Flag) {
+		if negations[f.Name] {
+			return
+		}
+		shown.Var(f.Value, f.Name, f.Usage)
+		// the value might be set already, e.g. when help is printed on a parse error.
+		shown.Lookup(f.Name).DefValue = f.DefValue
+	})
+	shown.PrintDefaults()
+}
+
+// usage returns help message for srcFlag with back-quoted placeholder,
+// allowed values, negation and, if withEnv is set, environment variables appended.
+func usage(srcFlag *sflags.Flag, withEnv bool) string {
+	usage := sflags.NegationUsage(srcFlag, sflags.ChoicesUsage(srcFlag, sflags.QuotedUsage(srcFlag)))
+	if envUsage := sflags.EnvUsage(srcFlag); withEnv && envUsage != "" {
+		if usage == "" {
+			return envUsage
+		}
+		usage += " " + envUsage
+	}
+	return usage
+}
+
+// ParseEnv sets flags from src, that weren't set in dst
+// by command line arguments, from their environment variables.
+// The first non empty variable from sflags.Flag.EnvNames is used,
+// then a file from "<ENV>_FILE" variable, if sflags.Flag.EnvFile is set.
+// Call it after dst is parsed.
+func ParseEnv(src []*sflags.Flag, dst envFlagSet) error {
+	actual := actualFlags(src, dst)
+	for _, srcFlag := range src {
+		if actual[srcFlag.Name] {
+			continue
+		}
+		envName, value, found := sflags.LookupEnv(srcFlag)
+		if found {
+			if err := dst.Set(srcFlag.Name, value); err != nil {
+				return fmt.Errorf("invalid value %q for env %s: %w", value, envName, err)
+			}
+			srcFlag.SetSource(sflags.Source{Kind: sflags.SourceEnv, Name: envName})
+			continue
+		}
+		envName, value, found, err := sflags.LookupEnvFile(srcFlag)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		if err := dst.Set(srcFlag.Name, value); err != nil {
+			return fmt.Errorf("invalid value from %s: %w", envName, err)
+		}
+		srcFlag.SetSource(sflags.Source{Kind: sflags.SourceEnv, Name: envName})
+	}
+	return nil
+}
+
+// CheckRequired returns an error listing all required flags from src,
+// that weren't set in dst, or array flags, that were set partially,
+// see sflags.CheckArrays. Call it after dst is parsed
+// and ParseEnv is called, if environment variables are used.
+func CheckRequired(src []*sflags.Flag, dst visitor) error {
+	actual := actualFlags(src, dst)
+	var missing []string
 	for _, srcFlag := range src {
-		dst.Var(srcFlag.Value, srcFlag.Name, srcFlag.Usage)
+		if srcFlag.Required && !actual[srcFlag.Name] {
+			missing = append(missing, srcFlag.Name)
+		}
 	}
+	if len(missing) > 0 {
+		return fmt.Errorf(`required flag(s) "%s" not set`, strings.Join(missing, `", "`))
+	}
+	return sflags.CheckArrays(src)
+}
+
+// CheckGroups returns an error, if flags from src, that were set in dst,
+// violate their groups, e.g. mutually exclusive flags are set together.
+// Call it after dst is parsed and ParseEnv is called,
+// if environment variables are used.
+func CheckGroups(src []*sflags.Flag, dst visitor) error {
+	actual := actualFlags(src, dst)
+	return sflags.CheckGroups(src, func(name string) bool { return actual[name] })
+}
+
+// actualFlags returns names of flags from src, that were set in dst
+// by themselves or by their negations.
+func actualFlags(src []*sflags.Flag, dst visitor) map[string]bool {
+	actual := map[string]bool{}
+	dst.Visit(func(f *flag.Flag) {
+		actual[f.Name] = true
+	})
+	for _, srcFlag := range src {
+		if negation := srcFlag.Negation(); negation != nil && actual[negation.Name] {
+			actual[srcFlag.Name] = true
+		}
+	}
+	return actual
+}
+
+// SetArgs takes a list of sflags.Arg,
+// that are parsed from some config structure, and sets them
+// from arguments remaining in src. Call it after src is parsed.
+func SetArgs(dst []*sflags.Arg, src argsGetter) error {
+	return sflags.SetArgs(dst, src.Args())
 }
 
 // ParseTo parses cfg, that is a pointer to some structure,
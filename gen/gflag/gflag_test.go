@@ -1,9 +1,12 @@
 package gflag
 
 import (
+	"bytes"
 	"errors"
 	"flag"
+	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -123,3 +126,219 @@ func TestParseToDef(t *testing.T) {
 	err = ParseToDef("bad string")
 	assert.Error(t, err)
 }
+
+func TestSetArgs(t *testing.T) {
+	cfg := &struct {
+		Verbose bool
+		Src     string   `arg:",required"`
+		Files   []string `arg:""`
+	}{}
+	flags, args, err := sflags.ParseStructWithArgs(cfg)
+	require.NoError(t, err)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	GenerateTo(flags, fs)
+	require.NoError(t, fs.Parse([]string{"-verbose", "in", "a", "b"}))
+	require.NoError(t, SetArgs(args, fs))
+	assert.True(t, cfg.Verbose)
+	assert.Equal(t, "in", cfg.Src)
+	assert.Equal(t, []string{"a", "b"}, cfg.Files)
+
+	require.NoError(t, fs.Parse([]string{}))
+	assert.EqualError(t, SetArgs(args, fs), "required argument src not provided")
+}
+
+func TestParseEnv(t *testing.T) {
+	cfg := &struct {
+		Host    string `env:"SFLAGS_HOST" desc:"http host"`
+		Port    int    `env:"SFLAGS_PORT"`
+		Verbose bool   `env:"SFLAGS_VERBOSE"`
+	}{Host: "localhost"}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	GenerateWithEnvTo(flags, fs)
+	assert.Equal(t, "http host [$SFLAGS_HOST]", fs.Lookup("host").Usage)
+	assert.Equal(t, "[$SFLAGS_PORT]", fs.Lookup("port").Usage)
+
+	t.Setenv("SFLAGS_HOST", "example.com")
+	t.Setenv("SFLAGS_PORT", "8080")
+	require.NoError(t, fs.Parse([]string{"-port", "9090"}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.Equal(t, "example.com", cfg.Host)
+	assert.Equal(t, 9090, cfg.Port)
+	assert.False(t, cfg.Verbose)
+
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	assert.Equal(t, map[string]bool{"host": true, "port": true}, set)
+
+	t.Setenv("SFLAGS_VERBOSE", "maybe")
+	assert.EqualError(t, ParseEnv(flags, fs),
+		`invalid value "maybe" for env SFLAGS_VERBOSE: strconv.ParseBool: parsing "maybe": invalid syntax`)
+}
+
+func TestCheckRequired(t *testing.T) {
+	cfg := &struct {
+		Host    string `flag:",required" env:"SFLAGS_HOST"`
+		Port    int    `flag:",required"`
+		User    string `flag:",required"`
+		Verbose bool
+	}{}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	GenerateTo(flags, fs)
+	require.NoError(t, fs.Parse([]string{"-verbose"}))
+	assert.EqualError(t, CheckRequired(flags, fs), `required flag(s) "host", "port", "user" not set`)
+
+	t.Setenv("SFLAGS_HOST", "localhost")
+	require.NoError(t, fs.Parse([]string{"-user", "admin"}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.EqualError(t, CheckRequired(flags, fs), `required flag(s) "port" not set`)
+
+	require.NoError(t, fs.Parse([]string{"-port", "80"}))
+	assert.NoError(t, CheckRequired(flags, fs))
+
+	arrayCfg := &struct {
+		Coords [2]float64 `default:"1,2"`
+	}{}
+	flags, err = sflags.ParseStruct(arrayCfg)
+	require.NoError(t, err)
+	fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	GenerateTo(flags, fs)
+	require.NoError(t, fs.Parse([]string{"-coords", "5"}))
+	assert.EqualError(t, CheckRequired(flags, fs), `array flag(s) "coords" (1 of 2 elements) set partially`)
+}
+
+func TestCheckGroups(t *testing.T) {
+	cfg := &struct {
+		Token    string `group:"auth-user,exclusive;auth-password,exclusive"`
+		User     string `group:"auth-user,exclusive;basic,together"`
+		Password string `group:"auth-password,exclusive;basic,together"`
+	}{}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		args   []string
+		expErr string
+	}{
+		{[]string{"-token", "t"}, ""},
+		{[]string{"-user", "u", "-password", "p"}, ""},
+		{[]string{"-token", "t", "-user", "u"}, `flags "token", "user" of group auth-user are mutually exclusive`},
+		{[]string{"-password", "p"}, `flags "user" of group basic must be set together with "password"`},
+	}
+	for _, test := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		GenerateTo(flags, fs)
+		require.NoError(t, fs.Parse(test.args))
+		err := CheckGroups(flags, fs)
+		if test.expErr == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, test.expErr)
+		}
+	}
+}
+
+func TestPlaceholder(t *testing.T) {
+	cfg := &struct {
+		Host   string `placeholder:"HOST" desc:"http host"`
+		Output string `desc:"write result to \x60FILE\x60"`
+	}{}
+	fs, err := Parse(cfg)
+	require.NoError(t, err)
+
+	name, usage := flag.UnquoteUsage(fs.Lookup("host"))
+	assert.Equal(t, "HOST", name)
+	assert.Equal(t, "HOST http host", usage)
+
+	name, usage = flag.UnquoteUsage(fs.Lookup("output"))
+	assert.Equal(t, "FILE", name)
+	assert.Equal(t, "write result to FILE", usage)
+}
+
+func TestNegatable(t *testing.T) {
+	cfg := &struct {
+		Color bool `flag:",negatable" env:"SFLAGS_COLOR"`
+	}{Color: true}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	help := &bytes.Buffer{}
+	fs.SetOutput(help)
+	GenerateWithEnvTo(flags, fs)
+	require.NotNil(t, fs.Lookup("no-color"))
+	assert.Equal(t, "negates --color", fs.Lookup("no-color").Usage)
+	fs.Usage()
+	assert.Equal(t, "Usage of test:\n"+
+		"  -color\n"+
+		"    \t(use --no-color to disable) [$SFLAGS_COLOR] (default true)\n"+
+		"  -no-color\n"+
+		"    \tnegates --color (default false)\n", help.String())
+
+	custom := flag.NewFlagSet("custom", flag.ContinueOnError)
+	custom.SetOutput(help)
+	custom.Usage = func() { PrintDefaults(flags, custom) }
+	GenerateTo(flags, custom)
+	help.Reset()
+	custom.Usage()
+	assert.Equal(t, "  -color\n    \t(use --no-color to disable) (default true)\n", help.String())
+
+	t.Setenv("SFLAGS_COLOR", "true")
+	require.NoError(t, fs.Parse([]string{"-no-color"}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.False(t, cfg.Color)
+}
+
+func TestParseEnv_EnvFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(path, []byte("qwerty\n"), 0o600))
+	t.Setenv("SFLAGS_PASSWORD_FILE", path)
+	t.Setenv("SFLAGS_PORT_FILE", path)
+
+	newFlagSet := func(cfg interface{}) ([]*sflags.Flag, *flag.FlagSet) {
+		flags, err := sflags.ParseStruct(cfg, sflags.EnvFile(), sflags.EnvPrefix("SFLAGS_"), sflags.TrackSource())
+		require.NoError(t, err)
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		GenerateWithEnvTo(flags, fs)
+		return flags, fs
+	}
+	cfg := &struct {
+		Password string `flag:",required" secret:"true"`
+	}{}
+	flags, fs := newFlagSet(cfg)
+	// config file is overridden by the file from environment
+	require.NoError(t, flags[0].SetFrom("from-config", sflags.Source{Kind: sflags.SourceFile, Name: "config.yaml"}))
+	require.NoError(t, fs.Parse([]string{}))
+	require.NoError(t, ParseEnv(flags, fs))
+	require.NoError(t, CheckRequired(flags, fs))
+	assert.Equal(t, "qwerty", cfg.Password)
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceEnv, Name: "SFLAGS_PASSWORD_FILE"}, flags[0].Source())
+
+	flags, fs = newFlagSet(cfg)
+	require.NoError(t, fs.Parse([]string{"-password", "secret"}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.Equal(t, "secret", cfg.Password)
+
+	t.Setenv("SFLAGS_PASSWORD", "env-secret")
+	flags, fs = newFlagSet(cfg)
+	require.NoError(t, fs.Parse([]string{}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.Equal(t, "env-secret", cfg.Password)
+
+	flags, fs = newFlagSet(&struct{ Port int }{})
+	require.NoError(t, fs.Parse([]string{}))
+	assert.ErrorContains(t, ParseEnv(flags, fs), "invalid value from SFLAGS_PORT_FILE: ")
+
+	t.Setenv("SFLAGS_PORT_FILE", filepath.Join(dir, "missing"))
+	flags, fs = newFlagSet(&struct{ Port int }{})
+	require.NoError(t, fs.Parse([]string{}))
+	assert.EqualError(t, ParseEnv(flags, fs),
+		"can't read SFLAGS_PORT_FILE: open "+filepath.Join(dir, "missing")+": no such file or directory")
+}
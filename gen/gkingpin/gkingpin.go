@@ -1,6 +1,9 @@
 package gkingpin
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/alecthomas/kingpin/v2"
@@ -11,21 +14,52 @@ type flagger interface {
 	Flag(name, help string) *kingpin.FlagClause
 }
 
+type arger interface {
+	Arg(name, help string) *kingpin.ArgClause
+}
+
+type commander interface {
+	flagger
+	arger
+	Command(name, help string) *kingpin.CmdClause
+}
+
+var _ commander = (*kingpin.Application)(nil)
+var _ commander = (*kingpin.CmdClause)(nil)
+
 // GenerateTo takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
+// kingpin negates all boolean flags by --no-<name> itself
+// and shows them as --[no-]name, so sflags.Flag.Negatable isn't needed.
+// If dst is kingpin.Application or kingpin.CmdClause, sources of values set
+// from environment variables are recorded and values from "<ENV>_FILE" variables
+// (see sflags.Flag.EnvFile) are set before actions.
 func GenerateTo(src []*sflags.Flag, dst flagger) {
+	var envFlags []envFlag
 	for _, srcFlag := range src {
-		flag := dst.Flag(srcFlag.Name, srcFlag.Usage)
+		flag := dst.Flag(srcFlag.Name, sflags.ChoicesUsage(srcFlag, srcFlag.Usage))
 		flag.SetValue(srcFlag.Value)
 		if len(srcFlag.EnvNames) > 0 && srcFlag.EnvNames[0] != "" {
 			flag.Envar(srcFlag.EnvNames[0])
+			envFlags = append(envFlags, envFlag{src: srcFlag, clause: flag})
 		}
 		if srcFlag.Hidden {
 			flag.Hidden()
 		}
-		if srcFlag.Required {
+		// kingpin doesn't know about "<ENV>_FILE" variables,
+		// so required flags with them are checked by an action.
+		if srcFlag.Required && !(srcFlag.EnvFile && hasActions(dst)) {
 			flag.Required()
 		}
+		// Enum and Enums would replace srcFlag.Value with kingpin values,
+		// that set only strings, so choices are checked by srcFlag.Value
+		// and kingpin gets them as completion hints.
+		if len(srcFlag.Choices) > 0 {
+			flag.HintOptions(srcFlag.Choices...)
+		}
+		if srcFlag.Placeholder != "" {
+			flag.PlaceHolder(srcFlag.Placeholder)
+		}
 		if srcFlag.Short != "" {
 			r, _ := utf8.DecodeRuneInString(srcFlag.Short)
 			if r != utf8.RuneError {
@@ -34,15 +68,148 @@ func GenerateTo(src []*sflags.Flag, dst flagger) {
 		}
 
 	}
+	if len(envFlags) > 0 && hasActions(dst) {
+		generateEnvActions(envFlags, dst)
+	}
+}
+
+// envFlag is a flag, that might be set from environment variables.
+type envFlag struct {
+	src    *sflags.Flag
+	clause *kingpin.FlagClause
+}
+
+// generateEnvActions adds actions to dst, that record sources of envFlags
+// set from environment variables, set values from "<ENV>_FILE" variables
+// and check required flags with them.
+func generateEnvActions(envFlags []envFlag, dst flagger) {
+	fromFile := map[*sflags.Flag]bool{}
+	setByArgs := func(ctx *kingpin.ParseContext) map[*kingpin.FlagClause]bool {
+		set := map[*kingpin.FlagClause]bool{}
+		for _, element := range ctx.Elements {
+			if clause, casted := element.Clause.(*kingpin.FlagClause); casted {
+				set[clause] = true
+			}
+		}
+		return set
+	}
+	// pre actions are run before kingpin validates required flags and arguments.
+	preAction := func(ctx *kingpin.ParseContext) error {
+		set := setByArgs(ctx)
+		for _, f := range envFlags {
+			if set[f.clause] {
+				continue
+			}
+			if f.clause.HasEnvarValue() {
+				f.src.SetSource(sflags.Source{Kind: sflags.SourceEnv, Name: f.src.EnvNames[0]})
+				continue
+			}
+			envName, value, found, err := sflags.LookupEnvFile(f.src)
+			if err != nil {
+				return err
+			}
+			if !found {
+				continue
+			}
+			if err := f.src.SetFrom(value, sflags.Source{Kind: sflags.SourceEnv, Name: envName}); err != nil {
+				return fmt.Errorf("invalid value from %s for flag '--%s': %w", envName, f.src.Name, err)
+			}
+			fromFile[f.src] = true
+		}
+		return nil
+	}
+	// actions are run after help is shown, so missing flags don't prevent it.
+	action := func(ctx *kingpin.ParseContext) error {
+		set := setByArgs(ctx)
+		var missing []string
+		for _, f := range envFlags {
+			if f.src.Required && f.src.EnvFile && !set[f.clause] && !f.clause.HasEnvarValue() && !fromFile[f.src] {
+				missing = append(missing, "'--"+f.src.Name+"'")
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("required flag(s) %s not provided", strings.Join(missing, ", "))
+		}
+		return nil
+	}
+	switch dst := dst.(type) {
+	case *kingpin.Application:
+		dst.PreAction(preAction).Action(action)
+	case *kingpin.CmdClause:
+		dst.PreAction(preAction).Action(action)
+	}
+}
+
+// hasActions checks that dst dispatches actions, see generateEnvActions.
+func hasActions(dst flagger) bool {
+	switch dst.(type) {
+	case *kingpin.Application, *kingpin.CmdClause:
+		return true
+	}
+	return false
+}
+
+// GenerateArgsTo takes a list of sflag.Arg,
+// that are parsed from some config structure, and put it to dst.
+func GenerateArgsTo(src []*sflags.Arg, dst arger) {
+	for _, srcArg := range src {
+		arg := dst.Arg(srcArg.Name, srcArg.Usage)
+		// kingpin consumes all remaining arguments for cumulative values
+		arg.SetValue(srcArg.Value)
+		if srcArg.Required {
+			arg.Required()
+		}
+	}
 }
 
 // ParseTo parses cfg, that is a pointer to some structure,
 // and puts it to dst.
+// Positional arguments are put to dst too, if it's kingpin.Application or kingpin.CmdClause.
 func ParseTo(cfg interface{}, dst flagger, optFuncs ...sflags.OptFunc) error {
-	flags, err := sflags.ParseStruct(cfg, optFuncs...)
+	flags, args, err := sflags.ParseStructWithArgs(cfg, optFuncs...)
 	if err != nil {
 		return err
 	}
 	GenerateTo(flags, dst)
+	if len(args) > 0 {
+		argDst, casted := dst.(arger)
+		if !casted {
+			return errors.New("positional arguments are not supported by dst")
+		}
+		GenerateArgsTo(args, argDst)
+	}
 	return nil
 }
+
+// GenerateCommandTo takes a sflags.Command,
+// that is parsed from some config structure, and puts it to dst.
+// Subcommands are added to dst as kingpin commands,
+// flags of parent commands are accepted by subcommands in kingpin.
+func GenerateCommandTo(src *sflags.Command, dst commander) {
+	GenerateTo(src.Flags, dst)
+	GenerateArgsTo(src.Args, dst)
+	for _, srcCmd := range src.Commands {
+		srcCmd := srcCmd
+		cmd := dst.Command(srcCmd.Name, srcCmd.Usage)
+		if srcCmd.Hidden {
+			cmd.Hidden()
+		}
+		cmd.Action(func(*kingpin.ParseContext) error {
+			srcCmd.Select()
+			return nil
+		})
+		GenerateCommandTo(srcCmd, cmd)
+	}
+}
+
+// ParseCommandTo parses cfg, that is a pointer to some structure,
+// puts its flags, positional arguments and subcommands to dst and returns parsed command tree.
+// Use Selected method of returned command to find out which subcommand was chosen.
+func ParseCommandTo(cfg interface{}, dst commander, optFuncs ...sflags.OptFunc) (*sflags.Command, error) {
+	cmd, err := sflags.ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	GenerateCommandTo(cmd, dst)
+	return cmd, nil
+}
@@ -2,6 +2,8 @@ package gkingpin
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/alecthomas/kingpin/v2"
@@ -153,3 +155,160 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestChoices(t *testing.T) {
+	cfg := &struct {
+		Format string `choices:"json,yaml" desc:"output format"`
+	}{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	require.NoError(t, ParseTo(cfg, app))
+
+	flag := app.GetFlag("format")
+	require.NotNil(t, flag)
+	assert.Equal(t, "output format (one of: json, yaml)", flag.Model().Help)
+
+	_, err := app.Parse([]string{"--format", "xml"})
+	require.EqualError(t, err,
+		`invalid value "xml", allowed values are: json, yaml`)
+	_, err = app.Parse([]string{"--format", "yaml"})
+	require.NoError(t, err)
+	assert.Equal(t, "yaml", cfg.Format)
+}
+
+func TestPlaceholder(t *testing.T) {
+	cfg := &struct {
+		Host   string `placeholder:"HOST" desc:"http host"`
+		Output string `desc:"write result to \x60FILE\x60"`
+	}{}
+	app := kingpin.New("testApp", "")
+	require.NoError(t, ParseTo(cfg, app))
+
+	flag := app.GetFlag("host")
+	require.NotNil(t, flag)
+	assert.Equal(t, "HOST", flag.Model().PlaceHolder)
+	assert.Equal(t, "http host", flag.Model().Help)
+
+	flag = app.GetFlag("output")
+	require.NotNil(t, flag)
+	assert.Equal(t, "FILE", flag.Model().PlaceHolder)
+	assert.Equal(t, "write result to FILE", flag.Model().Help)
+}
+
+func TestParseToArgs(t *testing.T) {
+	cfg := &struct {
+		Verbose bool
+		Src     string   `arg:",required" desc:"source"`
+		Files   []string `arg:""`
+	}{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	require.NoError(t, ParseTo(cfg, app))
+
+	_, err := app.Parse([]string{})
+	require.EqualError(t, err, "required argument 'src' not provided")
+
+	_, err = app.Parse([]string{"--verbose", "in", "a", "b"})
+	require.NoError(t, err)
+	assert.True(t, cfg.Verbose)
+	assert.Equal(t, "in", cfg.Src)
+	assert.Equal(t, []string{"a", "b"}, cfg.Files)
+}
+
+type serveCmd struct {
+	Port int    `default:"8080"`
+	Dir  string `arg:",required"`
+}
+
+type migrateUpCmd struct {
+	Steps int
+}
+
+type migrateCmd struct {
+	DryRun bool
+	Up     migrateUpCmd `cmd:"up"`
+}
+
+type rootCmd struct {
+	Verbose bool
+	Serve   serveCmd   `cmd:"serve" desc:"start server"`
+	Migrate migrateCmd `cmd:"migrate"`
+}
+
+func TestParseCommandTo(t *testing.T) {
+	cfg := &rootCmd{}
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	cmd, err := ParseCommandTo(cfg, app)
+	require.NoError(t, err)
+
+	selected, err := app.Parse([]string{"migrate", "up", "--verbose", "--dry-run", "--steps", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, "migrate up", selected)
+	assert.Equal(t, "up", cmd.Selected().Name)
+	assert.Equal(t, &rootCmd{
+		Verbose: true,
+		Serve:   serveCmd{Port: 8080},
+		Migrate: migrateCmd{DryRun: true, Up: migrateUpCmd{Steps: 3}},
+	}, cfg)
+
+	_, err = app.Parse([]string{"serve"})
+	require.EqualError(t, err, "required argument 'dir' not provided")
+}
+
+func TestEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(path, []byte("qwerty\n"), 0o600))
+	t.Setenv("DB_PASSWORD_FILE", path)
+
+	type config struct {
+		DBPassword string `flag:"db-password,required" env:"DB_PASSWORD"`
+	}
+	newApp := func() (*kingpin.Application, *config) {
+		cfg := &config{}
+		app := kingpin.New("testApp", "")
+		app.Terminate(nil)
+		require.NoError(t, ParseTo(cfg, app, sflags.EnvFile()))
+		return app, cfg
+	}
+
+	app, cfg := newApp()
+	_, err := app.Parse([]string{})
+	require.NoError(t, err)
+	assert.Equal(t, "qwerty", cfg.DBPassword)
+
+	app, cfg = newApp()
+	_, err = app.Parse([]string{"--db-password", "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", cfg.DBPassword)
+
+	t.Setenv("DB_PASSWORD", "env-secret")
+	app, cfg = newApp()
+	_, err = app.Parse([]string{})
+	require.NoError(t, err)
+	assert.Equal(t, "env-secret", cfg.DBPassword)
+
+	t.Setenv("DB_PASSWORD", "")
+	t.Setenv("DB_PASSWORD_FILE", "")
+	app, _ = newApp()
+	_, err = app.Parse([]string{})
+	assert.EqualError(t, err, "required flag(s) '--db-password' not provided")
+}
+
+func TestEnvSource(t *testing.T) {
+	t.Setenv("PORT", "9000")
+	cfg := &struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT"`
+	}{}
+	flags, err := sflags.ParseStruct(cfg, sflags.TrackSource())
+	require.NoError(t, err)
+	app := kingpin.New("testApp", "")
+	app.Terminate(nil)
+	GenerateTo(flags, app)
+	_, err = app.Parse([]string{"--host", "localhost"})
+	require.NoError(t, err)
+	assert.Equal(t, 9000, cfg.Port)
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceCLI}, flags[0].Source())
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceEnv, Name: "PORT"}, flags[1].Source())
+}
@@ -0,0 +1,76 @@
+package gpflag
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/urfave/sflags"
+)
+
+// GenerateCommandTo takes a sflags.Command,
+// that is parsed from some config structure, and puts it to dst.
+// Flags of dst and of every subcommand are put to persistent flags,
+// so they are available for nested subcommands too.
+// Subcommands are added to dst as new cobra commands. Leaf ones have empty Run,
+// because cobra prints help instead of executing commands without it,
+// ones with subcommands print help and reject unknown subcommands.
+// Replace Run of a subcommand, e.g. found by dst.Find, to handle it,
+// or check Selected method of src after dst is executed.
+// Executed subcommands also reject array flags, that are set partially.
+func GenerateCommandTo(src *sflags.Command, dst *cobra.Command) {
+	generateCommandTo(src, dst, nil)
+}
+
+// generateCommandTo works like GenerateCommandTo,
+// parentFlags are flags of all parents of src.
+func generateCommandTo(src *sflags.Command, dst *cobra.Command, parentFlags []*sflags.Flag) {
+	GenerateTo(src.Flags, dst.PersistentFlags())
+	flags := append(parentFlags[:len(parentFlags):len(parentFlags)], src.Flags...)
+	if len(src.Args) > 0 {
+		dst.Args = func(_ *cobra.Command, args []string) error {
+			return sflags.SetArgs(src.Args, args)
+		}
+	}
+	for _, srcCmd := range src.Commands {
+		srcCmd := srcCmd
+		cmd := &cobra.Command{
+			Use:    srcCmd.Name,
+			Short:  srcCmd.Usage,
+			Hidden: srcCmd.Hidden,
+			Run:    func(*cobra.Command, []string) {},
+		}
+		if len(srcCmd.Commands) > 0 {
+			// cobra checks subcommand names only for the root command,
+			// a typo like "serve strat" is left as an argument of "serve".
+			cmd.Args = cobra.NoArgs
+			cmd.Run = func(cmd *cobra.Command, _ []string) { _ = cmd.Help() }
+		}
+		generateCommandTo(srcCmd, cmd, flags)
+		cmdFlags := append(flags[:len(flags):len(flags)], srcCmd.Flags...)
+		// cobra validates positional arguments only for the executed command
+		// after its flags are parsed, so it's a good place to mark it
+		// as selected and to check its arrays.
+		validateArgs := cmd.Args
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			srcCmd.Select()
+			if err := sflags.CheckArrays(cmdFlags); err != nil {
+				return err
+			}
+			if validateArgs != nil {
+				return validateArgs(cmd, args)
+			}
+			return nil
+		}
+		dst.AddCommand(cmd)
+	}
+}
+
+// ParseCommandTo parses cfg, that is a pointer to some structure,
+// puts its flags and subcommands to dst and returns parsed command tree.
+// Use Selected method of returned command to find out which subcommand was executed.
+func ParseCommandTo(cfg interface{}, dst *cobra.Command, optFuncs ...sflags.OptFunc) (*sflags.Command, error) {
+	cmd, err := sflags.ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	GenerateCommandTo(cmd, dst)
+	return cmd, nil
+}
@@ -0,0 +1,143 @@
+package gpflag
+
+import (
+	"io"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type serveCmd struct {
+	Port int    `default:"8080"`
+	Dir  string `arg:",required"`
+}
+
+type migrateUpCmd struct {
+	Steps int
+}
+
+type migrateCmd struct {
+	DryRun bool
+	Up     migrateUpCmd `cmd:"up"`
+}
+
+type rootCmd struct {
+	Verbose bool
+	Serve   serveCmd   `cmd:"serve" desc:"start server"`
+	Migrate migrateCmd `cmd:"migrate"`
+}
+
+func newRootCommand(t *testing.T, cfg *rootCmd) *cobra.Command {
+	root := &cobra.Command{Use: "app"}
+	root.SetOutput(io.Discard)
+	_, err := ParseCommandTo(cfg, root)
+	require.NoError(t, err)
+	return root
+}
+
+func TestParseCommandTo(t *testing.T) {
+	cfg := &rootCmd{}
+	root := &cobra.Command{Use: "app"}
+	cmd, err := ParseCommandTo(cfg, root)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(root.Commands()))
+	assert.NotNil(t, root.PersistentFlags().Lookup("verbose"))
+
+	serve := root.Commands()[1]
+	assert.Equal(t, "serve", serve.Name())
+	assert.Equal(t, "start server", serve.Short)
+	assert.NotNil(t, serve.PersistentFlags().Lookup("port"))
+	assert.Equal(t, cmd, cmd.Selected())
+}
+
+func TestParseCommandTo_Execute(t *testing.T) {
+	cfg := &rootCmd{}
+	root := newRootCommand(t, cfg)
+	root.SetArgs([]string{"migrate", "--verbose", "up", "--dry-run", "--steps", "3"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, &rootCmd{
+		Verbose: true,
+		Serve:   serveCmd{Port: 8080},
+		Migrate: migrateCmd{DryRun: true, Up: migrateUpCmd{Steps: 3}},
+	}, cfg)
+
+	cfg = &rootCmd{}
+	root = newRootCommand(t, cfg)
+	root.SetArgs([]string{"serve", "--port", "9000", "/tmp"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, 9000, cfg.Serve.Port)
+	assert.Equal(t, "/tmp", cfg.Serve.Dir)
+
+	root = newRootCommand(t, &rootCmd{})
+	root.SetArgs([]string{"serve"})
+	assert.EqualError(t, root.Execute(), "required argument dir not provided")
+}
+
+func TestParseCommandTo_Selected(t *testing.T) {
+	root := &cobra.Command{Use: "app"}
+	root.SetOutput(io.Discard)
+	cmd, err := ParseCommandTo(&rootCmd{}, root)
+	require.NoError(t, err)
+	root.SetArgs([]string{"migrate", "up"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, "up", cmd.Selected().Name)
+
+	root = &cobra.Command{Use: "app"}
+	root.SetOutput(io.Discard)
+	cmd, err = ParseCommandTo(&rootCmd{}, root)
+	require.NoError(t, err)
+	serve, _, err := root.Find([]string{"serve"})
+	require.NoError(t, err)
+	var handled []string
+	serve.Run = func(_ *cobra.Command, args []string) { handled = args }
+	root.SetArgs([]string{"serve", "/tmp"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, []string{"/tmp"}, handled)
+	assert.Equal(t, "serve", cmd.Selected().Name)
+}
+
+func TestParseCommandTo_UnknownCommand(t *testing.T) {
+	root := newRootCommand(t, &rootCmd{})
+	root.SetArgs([]string{"migrate", "upp"})
+	assert.EqualError(t, root.Execute(), `unknown command "upp" for "app migrate"`)
+
+	cfg := &rootCmd{}
+	root = &cobra.Command{Use: "app"}
+	root.SetOutput(io.Discard)
+	cmd, err := ParseCommandTo(cfg, root)
+	require.NoError(t, err)
+	root.SetArgs([]string{"migrate"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, "migrate", cmd.Selected().Name)
+}
+
+func TestParseCommandTo_Arrays(t *testing.T) {
+	cfg := &struct {
+		Coords [2]float64 `default:"1,2"`
+		Move   struct {
+			To [2]float64
+		} `cmd:"move"`
+	}{}
+	root := &cobra.Command{Use: "app"}
+	root.SetOutput(io.Discard)
+	_, err := ParseCommandTo(cfg, root)
+	require.NoError(t, err)
+	root.SetArgs([]string{"move", "--coords", "5", "--to", "3", "--to", "4"})
+	assert.EqualError(t, root.Execute(), `array flag(s) "coords" (1 of 2 elements) set partially`)
+}
+
+func TestCobraRequiredFlags(t *testing.T) {
+	cfg := &struct {
+		Host string `flag:",required"`
+		Port int    `flag:",required"`
+	}{}
+	root := &cobra.Command{Use: "app", Run: func(*cobra.Command, []string) {}}
+	root.SetOutput(io.Discard)
+	_, err := ParseCommandTo(cfg, root)
+	require.NoError(t, err)
+
+	root.SetArgs([]string{"--port", "80"})
+	assert.EqualError(t, root.Execute(), `required flag(s) "host" not set`)
+}
@@ -1,8 +1,11 @@
 package gpflag
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/urfave/sflags"
 )
@@ -15,25 +18,167 @@ type flagSet interface {
 
 var _ flagSet = (*pflag.FlagSet)(nil)
 
+// argsGetter describes interface,
+// that's implemented by pflag library and required to set positional arguments.
+type argsGetter interface {
+	Args() []string
+}
+
+var _ argsGetter = (*pflag.FlagSet)(nil)
+
+// changedGetter describes interface,
+// that's implemented by pflag library and required to find out which flags were set.
+type changedGetter interface {
+	Changed(name string) bool
+}
+
+var _ changedGetter = (*pflag.FlagSet)(nil)
+
+// envFlagSet describes interface,
+// that's implemented by pflag library and required to set flags from environment.
+type envFlagSet interface {
+	changedGetter
+	Set(name, value string) error
+}
+
+var _ envFlagSet = (*pflag.FlagSet)(nil)
+
 // GenerateTo takes a list of sflag.Flag,
 // that are parsed from some config structure, and put it to dst.
 func GenerateTo(src []*sflags.Flag, dst flagSet) {
+	generateTo(src, dst, false)
+}
+
+// GenerateWithEnvTo works like GenerateTo, but also appends
+// environment variables hints, e.g. "[$HTTP_HOST]", to usage.
+// Call ParseEnv after dst is parsed to apply environment variables.
+func GenerateWithEnvTo(src []*sflags.Flag, dst flagSet) {
+	generateTo(src, dst, true)
+}
+
+func generateTo(src []*sflags.Flag, dst flagSet, withEnv bool) {
+	for _, srcFlag := range src {
+		generateFlag(srcFlag, dst, withEnv)
+		if negation := srcFlag.Negation(); negation != nil {
+			generateFlag(negation, dst, false)
+		}
+	}
+}
+
+// generateFlag puts srcFlag to dst.
+func generateFlag(srcFlag *sflags.Flag, dst flagSet, withEnv bool) {
+	flag := dst.VarPF(srcFlag.Value, srcFlag.Name, srcFlag.Short, usage(srcFlag, withEnv))
+	if boolFlag, casted := srcFlag.Value.(sflags.BoolFlag); casted && boolFlag.IsBoolFlag() {
+		// pflag uses -1 in this case,
+		// we will use the same behaviour as in flag library
+		flag.NoOptDefVal = "true"
+	}
+	flag.Hidden = srcFlag.Hidden
+	if srcFlag.Required {
+		// the same as cobra.MarkFlagRequired does,
+		// so cobra checks required flags and shows them in completions.
+		if flag.Annotations == nil {
+			flag.Annotations = map[string][]string{}
+		}
+		flag.Annotations[cobra.BashCompOneRequiredFlag] = []string{"true"}
+	}
+	if srcFlag.Deprecated {
+		// we use Usage as Deprecated message for a pflag
+		flag.Deprecated = srcFlag.Usage
+		if flag.Deprecated == "" {
+			flag.Deprecated = "Deprecated"
+		}
+	}
+}
+
+// usage returns help message for srcFlag with back-quoted placeholder,
+// allowed values, negation and, if withEnv is set, environment variables appended.
+func usage(srcFlag *sflags.Flag, withEnv bool) string {
+	usage := sflags.NegationUsage(srcFlag, sflags.ChoicesUsage(srcFlag, sflags.QuotedUsage(srcFlag)))
+	if envUsage := sflags.EnvUsage(srcFlag); withEnv && envUsage != "" {
+		if usage == "" {
+			return envUsage
+		}
+		usage += " " + envUsage
+	}
+	return usage
+}
+
+// ParseEnv sets flags from src, that weren't changed in dst
+// by command line arguments, from their environment variables.
+// The first non empty variable from sflags.Flag.EnvNames is used,
+// then a file from "<ENV>_FILE" variable, if sflags.Flag.EnvFile is set.
+// Call it after dst is parsed.
+func ParseEnv(src []*sflags.Flag, dst envFlagSet) error {
 	for _, srcFlag := range src {
-		flag := dst.VarPF(srcFlag.Value, srcFlag.Name, srcFlag.Short, srcFlag.Usage)
-		if boolFlag, casted := srcFlag.Value.(sflags.BoolFlag); casted && boolFlag.IsBoolFlag() {
-			// pflag uses -1 in this case,
-			// we will use the same behaviour as in flag library
-			flag.NoOptDefVal = "true"
+		if changed(srcFlag, dst) {
+			continue
 		}
-		flag.Hidden = srcFlag.Hidden
-		if srcFlag.Deprecated {
-			// we use Usage as Deprecated message for a pflag
-			flag.Deprecated = srcFlag.Usage
-			if flag.Deprecated == "" {
-				flag.Deprecated = "Deprecated"
+		envName, value, found := sflags.LookupEnv(srcFlag)
+		if found {
+			if err := dst.Set(srcFlag.Name, value); err != nil {
+				return fmt.Errorf("invalid value %q for env %s: %w", value, envName, err)
 			}
+			srcFlag.SetSource(sflags.Source{Kind: sflags.SourceEnv, Name: envName})
+			continue
+		}
+		envName, value, found, err := sflags.LookupEnvFile(srcFlag)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		if err := dst.Set(srcFlag.Name, value); err != nil {
+			return fmt.Errorf("invalid value from %s: %w", envName, err)
+		}
+		srcFlag.SetSource(sflags.Source{Kind: sflags.SourceEnv, Name: envName})
+	}
+	return nil
+}
+
+// CheckRequired returns an error listing all required flags from src,
+// that weren't changed in dst, or array flags, that were set partially,
+// see sflags.CheckArrays. Call it after dst is parsed
+// and ParseEnv is called, if environment variables are used.
+// Cobra commands check required flags themselves.
+func CheckRequired(src []*sflags.Flag, dst changedGetter) error {
+	var missing []string
+	for _, srcFlag := range src {
+		if srcFlag.Required && !changed(srcFlag, dst) {
+			missing = append(missing, srcFlag.Name)
 		}
 	}
+	if len(missing) > 0 {
+		return fmt.Errorf(`required flag(s) "%s" not set`, strings.Join(missing, `", "`))
+	}
+	return sflags.CheckArrays(src)
+}
+
+// CheckGroups returns an error, if flags from src, that were set in dst,
+// violate their groups, e.g. mutually exclusive flags are set together.
+// cobra v0.0.3 doesn't validate groups, so call it in PreRunE.
+func CheckGroups(src []*sflags.Flag, dst changedGetter) error {
+	set := make(map[string]bool, len(src))
+	for _, srcFlag := range src {
+		set[srcFlag.Name] = changed(srcFlag, dst)
+	}
+	return sflags.CheckGroups(src, func(name string) bool { return set[name] })
+}
+
+// changed reports whether srcFlag was changed in dst by itself or by its negation.
+func changed(srcFlag *sflags.Flag, dst changedGetter) bool {
+	if negation := srcFlag.Negation(); negation != nil && dst.Changed(negation.Name) {
+		return true
+	}
+	return dst.Changed(srcFlag.Name)
+}
+
+// SetArgs takes a list of sflags.Arg,
+// that are parsed from some config structure, and sets them
+// from arguments remaining in src. Call it after src is parsed.
+func SetArgs(dst []*sflags.Arg, src argsGetter) error {
+	return sflags.SetArgs(dst, src.Args())
 }
 
 // ParseTo parses cfg, that is a pointer to some structure,
@@ -5,9 +5,11 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -297,3 +299,214 @@ func TestPFlagGetters(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []int{10, 20}, intSliceValue)
 }
+
+func TestChoicesUsage(t *testing.T) {
+	cfg := &struct {
+		Format string `choices:"json,yaml" desc:"output format"`
+		Level  string `choices:"debug,info"`
+	}{}
+	flagSet, err := Parse(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "output format (one of: json, yaml)", flagSet.Lookup("format").Usage)
+	assert.Equal(t, "one of: debug, info", flagSet.Lookup("level").Usage)
+
+	flagSet.Init("pflagTest", pflag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+	err = flagSet.Parse([]string{"--format", "xml"})
+	require.EqualError(t, err,
+		`invalid argument "xml" for "--format" flag: invalid value "xml", allowed values are: json, yaml`)
+}
+
+func TestSetArgs(t *testing.T) {
+	cfg := &struct {
+		Verbose bool
+		Src     string   `arg:",required"`
+		Files   []string `arg:""`
+	}{}
+	flags, args, err := sflags.ParseStructWithArgs(cfg)
+	require.NoError(t, err)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	GenerateTo(flags, fs)
+	require.NoError(t, fs.Parse([]string{"in", "--verbose", "a", "b"}))
+	require.NoError(t, SetArgs(args, fs))
+	assert.True(t, cfg.Verbose)
+	assert.Equal(t, "in", cfg.Src)
+	assert.Equal(t, []string{"a", "b"}, cfg.Files)
+}
+
+func TestParseEnv(t *testing.T) {
+	cfg := &struct {
+		Host    string `env:"SFLAGS_HOST" desc:"http host"`
+		Port    int    `env:"SFLAGS_PORT"`
+		Verbose bool   `env:"SFLAGS_VERBOSE"`
+	}{Host: "localhost"}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	GenerateWithEnvTo(flags, fs)
+	assert.Equal(t, "http host [$SFLAGS_HOST]", fs.Lookup("host").Usage)
+	assert.Equal(t, "[$SFLAGS_PORT]", fs.Lookup("port").Usage)
+
+	t.Setenv("SFLAGS_HOST", "example.com")
+	t.Setenv("SFLAGS_PORT", "8080")
+	require.NoError(t, fs.Parse([]string{"--port", "9090"}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.Equal(t, "example.com", cfg.Host)
+	assert.Equal(t, 9090, cfg.Port)
+	assert.False(t, cfg.Verbose)
+	assert.True(t, fs.Changed("host"))
+	assert.False(t, fs.Changed("verbose"))
+
+	t.Setenv("SFLAGS_VERBOSE", "maybe")
+	assert.EqualError(t, ParseEnv(flags, fs),
+		`invalid value "maybe" for env SFLAGS_VERBOSE: invalid argument "maybe" for "--verbose" flag: strconv.ParseBool: parsing "maybe": invalid syntax`)
+}
+
+func TestCheckRequired(t *testing.T) {
+	cfg := &struct {
+		Host    string `flag:",required" env:"SFLAGS_HOST"`
+		Port    int    `flag:",required"`
+		User    string `flag:",required"`
+		Verbose bool
+	}{}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	GenerateTo(flags, fs)
+	assert.Equal(t, []string{"true"}, fs.Lookup("port").Annotations[cobra.BashCompOneRequiredFlag])
+	assert.Nil(t, fs.Lookup("verbose").Annotations)
+
+	require.NoError(t, fs.Parse([]string{"--verbose"}))
+	assert.EqualError(t, CheckRequired(flags, fs), `required flag(s) "host", "port", "user" not set`)
+
+	t.Setenv("SFLAGS_HOST", "localhost")
+	require.NoError(t, fs.Parse([]string{"--user", "admin"}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.EqualError(t, CheckRequired(flags, fs), `required flag(s) "port" not set`)
+
+	require.NoError(t, fs.Parse([]string{"--port", "80"}))
+	assert.NoError(t, CheckRequired(flags, fs))
+
+	arrayCfg := &struct {
+		Coords [2]float64 `default:"1,2"`
+	}{}
+	flags, err = sflags.ParseStruct(arrayCfg)
+	require.NoError(t, err)
+	fs = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	GenerateTo(flags, fs)
+	require.NoError(t, fs.Parse([]string{"--coords", "5"}))
+	assert.EqualError(t, CheckRequired(flags, fs), `array flag(s) "coords" (1 of 2 elements) set partially`)
+}
+
+func TestCheckGroups(t *testing.T) {
+	type tlsConfig struct {
+		Cert string
+		Key  string
+	}
+	cfg := &struct {
+		Token string    `group:"auth,exclusive"`
+		User  string    `group:"auth,exclusive"`
+		TLS   tlsConfig `group:"tls,together"`
+	}{}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	GenerateTo(flags, fs)
+
+	require.NoError(t, fs.Parse([]string{"--tls-key", "key.pem"}))
+	assert.EqualError(t, CheckGroups(flags, fs), `flags "tls-cert" of group tls must be set together with "tls-key"`)
+	require.NoError(t, fs.Parse([]string{"--tls-cert", "cert.pem", "--token", "t", "--user", "u"}))
+	assert.EqualError(t, CheckGroups(flags, fs), `flags "token", "user" of group auth are mutually exclusive`)
+}
+
+func TestPlaceholder(t *testing.T) {
+	cfg := &struct {
+		Host   string `placeholder:"HOST" desc:"http host"`
+		Output string `desc:"write result to \x60FILE\x60"`
+	}{}
+	fs, err := Parse(cfg)
+	require.NoError(t, err)
+
+	name, usage := pflag.UnquoteUsage(fs.Lookup("host"))
+	assert.Equal(t, "HOST", name)
+	assert.Equal(t, "HOST http host", usage)
+
+	name, usage = pflag.UnquoteUsage(fs.Lookup("output"))
+	assert.Equal(t, "FILE", name)
+	assert.Equal(t, "write result to FILE", usage)
+	assert.Contains(t, fs.FlagUsages(), "--output FILE")
+}
+
+func TestNegatable(t *testing.T) {
+	cfg := &struct {
+		Color bool `flag:",negatable" env:"SFLAGS_COLOR"`
+	}{Color: true}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	GenerateWithEnvTo(flags, fs)
+	require.NotNil(t, fs.Lookup("no-color"))
+	assert.True(t, fs.Lookup("no-color").Hidden)
+	assert.Equal(t, "      --color   (use --no-color to disable) [$SFLAGS_COLOR] (default true)\n", fs.FlagUsages())
+
+	t.Setenv("SFLAGS_COLOR", "true")
+	require.NoError(t, fs.Parse([]string{"--no-color"}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.False(t, cfg.Color)
+	assert.NoError(t, CheckRequired(flags, fs))
+}
+
+func TestParseEnv_EnvFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(path, []byte("qwerty\n"), 0o600))
+	t.Setenv("SFLAGS_PASSWORD_FILE", path)
+	t.Setenv("SFLAGS_PORT_FILE", path)
+
+	newFlagSet := func(cfg interface{}) ([]*sflags.Flag, *pflag.FlagSet) {
+		flags, err := sflags.ParseStruct(cfg, sflags.EnvFile(), sflags.EnvPrefix("SFLAGS_"), sflags.TrackSource())
+		require.NoError(t, err)
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		GenerateWithEnvTo(flags, fs)
+		return flags, fs
+	}
+	cfg := &struct {
+		Password string `flag:",required" secret:"true"`
+	}{}
+	flags, fs := newFlagSet(cfg)
+	assert.NotContains(t, fs.FlagUsages(), "qwerty")
+	// config file is overridden by the file from environment
+	require.NoError(t, flags[0].SetFrom("from-config", sflags.Source{Kind: sflags.SourceFile, Name: "config.yaml"}))
+	require.NoError(t, fs.Parse([]string{}))
+	require.NoError(t, ParseEnv(flags, fs))
+	require.NoError(t, CheckRequired(flags, fs))
+	assert.Equal(t, "qwerty", cfg.Password)
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceEnv, Name: "SFLAGS_PASSWORD_FILE"}, flags[0].Source())
+
+	flags, fs = newFlagSet(cfg)
+	require.NoError(t, fs.Parse([]string{"--password", "secret"}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.Equal(t, "secret", cfg.Password)
+
+	t.Setenv("SFLAGS_PASSWORD", "env-secret")
+	flags, fs = newFlagSet(cfg)
+	require.NoError(t, fs.Parse([]string{}))
+	require.NoError(t, ParseEnv(flags, fs))
+	assert.Equal(t, "env-secret", cfg.Password)
+
+	flags, fs = newFlagSet(&struct{ Port int }{})
+	require.NoError(t, fs.Parse([]string{}))
+	assert.ErrorContains(t, ParseEnv(flags, fs), "invalid value from SFLAGS_PORT_FILE: ")
+
+	t.Setenv("SFLAGS_PORT_FILE", filepath.Join(dir, "missing"))
+	flags, fs = newFlagSet(&struct{ Port int }{})
+	require.NoError(t, fs.Parse([]string{}))
+	assert.EqualError(t, ParseEnv(flags, fs),
+		"can't read SFLAGS_PORT_FILE: open "+filepath.Join(dir, "missing")+": no such file or directory")
+}
@@ -0,0 +1,107 @@
+package sflags
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GroupKind defines how flags of a group depend on each other.
+type GroupKind int
+
+// Kinds of groups.
+const (
+	GroupExclusive GroupKind = iota // at most one flag of a group might be set
+	GroupTogether                   // all flags of a group must be set, if any of them is set
+)
+
+// String returns the name of GroupKind as it's used in `group` tag.
+func (k GroupKind) String() string {
+	switch k {
+	case GroupExclusive:
+		return "exclusive"
+	case GroupTogether:
+		return "together"
+	default:
+		return "unknown"
+	}
+}
+
+// Group is a named group of flags. Flags of a structure are added to a group
+// by `group:"name,kind"` tag on their fields or on the structure field itself.
+type Group struct {
+	Name string
+	Kind GroupKind
+}
+
+// parseGroupTag parses groups separated by semicolon,
+// e.g. "auth,exclusive;tls,together".
+func parseGroupTag(tag string) ([]Group, error) {
+	if tag == "" {
+		return nil, nil
+	}
+	var groups []Group
+	for _, part := range strings.Split(tag, ";") {
+		name, kind, _ := strings.Cut(strings.TrimSpace(part), ",")
+		if name == "" {
+			return nil, fmt.Errorf("group name is empty in %q", tag)
+		}
+		group := Group{Name: name}
+		switch kind {
+		case GroupExclusive.String():
+			group.Kind = GroupExclusive
+		case GroupTogether.String():
+			group.Kind = GroupTogether
+		default:
+			return nil, fmt.Errorf("unknown kind %q of group %s, use exclusive or together", kind, name)
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// GroupFlags returns groups of flags in order of appearance
+// and names of flags in each of them. A flag is listed once,
+// even if the group is declared on both a nested structure and its field.
+func GroupFlags(flags []*Flag) ([]Group, map[Group][]string) {
+	var groups []Group
+	names := map[Group][]string{}
+	for _, flag := range flags {
+		for _, group := range flag.Groups {
+			groupNames, found := names[group]
+			if !found {
+				groups = append(groups, group)
+			}
+			if hasOption(groupNames, flag.Name) {
+				continue
+			}
+			names[group] = append(groupNames, flag.Name)
+		}
+	}
+	return groups, names
+}
+
+// CheckGroups returns an error for the first group of flags,
+// that is violated. isSet reports whether a flag was set,
+// e.g. on command line or from environment.
+func CheckGroups(flags []*Flag, isSet func(name string) bool) error {
+	groups, names := GroupFlags(flags)
+	for _, group := range groups {
+		var set, unset []string
+		for _, name := range names[group] {
+			if isSet(name) {
+				set = append(set, name)
+			} else {
+				unset = append(unset, name)
+			}
+		}
+		switch {
+		case group.Kind == GroupExclusive && len(set) > 1:
+			return fmt.Errorf(`flags "%s" of group %s are mutually exclusive`,
+				strings.Join(set, `", "`), group.Name)
+		case group.Kind == GroupTogether && len(set) > 0 && len(unset) > 0:
+			return fmt.Errorf(`flags "%s" of group %s must be set together with "%s"`,
+				strings.Join(unset, `", "`), group.Name, strings.Join(set, `", "`))
+		}
+	}
+	return nil
+}
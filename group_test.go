@@ -0,0 +1,93 @@
+package sflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStruct_Groups(t *testing.T) {
+	type tlsConfig struct {
+		Cert string
+		Key  string `group:"key,exclusive"`
+	}
+	cfg := &struct {
+		Token string    `group:"auth,exclusive"`
+		User  string    `group:"auth,exclusive;basic,together"`
+		TLS   tlsConfig `group:"tls,together"`
+		Debug bool
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 5)
+	assert.Equal(t, []Group{{"auth", GroupExclusive}}, flags[0].Groups)
+	assert.Equal(t, []Group{{"auth", GroupExclusive}, {"basic", GroupTogether}}, flags[1].Groups)
+	assert.Equal(t, []Group{{"tls", GroupTogether}}, flags[2].Groups)
+	assert.Equal(t, []Group{{"tls", GroupTogether}, {"key", GroupExclusive}}, flags[3].Groups)
+	assert.Nil(t, flags[4].Groups)
+
+	groups, names := GroupFlags(flags)
+	assert.Equal(t, []Group{{"auth", GroupExclusive}, {"basic", GroupTogether}, {"tls", GroupTogether}, {"key", GroupExclusive}}, groups)
+	assert.Equal(t, []string{"tls-cert", "tls-key"}, names[Group{"tls", GroupTogether}])
+}
+
+func TestParseStruct_GroupErrors(t *testing.T) {
+	_, err := ParseStruct(&struct {
+		Token string `group:"auth"`
+	}{})
+	assert.EqualError(t, err, `field Token: unknown kind "" of group auth, use exclusive or together`)
+	_, err = ParseStruct(&struct {
+		Token string `group:",exclusive"`
+	}{})
+	assert.EqualError(t, err, `field Token: group name is empty in ",exclusive"`)
+}
+
+func TestCheckGroups(t *testing.T) {
+	flags := []*Flag{
+		{Name: "a", Groups: []Group{{"ab", GroupExclusive}}},
+		{Name: "b", Groups: []Group{{"ab", GroupExclusive}, {"bc", GroupTogether}}},
+		{Name: "c", Groups: []Group{{"bc", GroupTogether}}},
+	}
+	tests := []struct {
+		set    []string
+		expErr string
+	}{
+		{nil, ""},
+		{[]string{"a"}, ""},
+		{[]string{"b", "c"}, ""},
+		{[]string{"a", "b", "c"}, `flags "a", "b" of group ab are mutually exclusive`},
+		{[]string{"c"}, `flags "b" of group bc must be set together with "c"`},
+	}
+	for _, test := range tests {
+		err := CheckGroups(flags, func(name string) bool {
+			for _, set := range test.set {
+				if set == name {
+					return true
+				}
+			}
+			return false
+		})
+		if test.expErr == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, test.expErr)
+		}
+	}
+}
+
+func TestGroupFlags_Duplicates(t *testing.T) {
+	type authConfig struct {
+		Token string `group:"auth,exclusive"`
+		User  string
+	}
+	cfg := &struct {
+		Auth authConfig `group:"auth,exclusive"`
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	groups, names := GroupFlags(flags)
+	require.Equal(t, []Group{{"auth", GroupExclusive}}, groups)
+	assert.Equal(t, []string{"auth-token", "auth-user"}, names[groups[0]])
+	assert.NoError(t, CheckGroups(flags, func(name string) bool { return name == "auth-token" }))
+}
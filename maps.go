@@ -0,0 +1,173 @@
+package sflags
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseMapValue returns a Value for an addressable map, that isn't generated,
+// e.g. map[string][]string, map[time.Duration]int or map[netip.Addr]string.
+// Keys might be of any comparable type, that has a Value, or of MapAllowedKinds,
+// values might be of any type, that has a Value, including slices.
+// It returns nil for other maps.
+func parseMapValue(value reflect.Value) Value {
+	typ := value.Type()
+	if !isMapKey(typ.Key()) || newElemValue(reflect.New(typ.Elem())) == nil {
+		return nil
+	}
+	if value.IsNil() {
+		value.Set(reflect.MakeMap(typ))
+	}
+	return &mapValue{value: value}
+}
+
+func isMapKey(typ reflect.Type) bool {
+	if !typ.Comparable() {
+		return false
+	}
+	return newElemValue(reflect.New(typ)) != nil || anyOf(MapAllowedKinds, typ.Kind())
+}
+
+// newElemValue returns a Value for ptr, that points to a key
+// or a value of a map, or nil, if there is no Value for it.
+func newElemValue(ptr reflect.Value) Value {
+	if val := parseGenerated(ptr.Interface()); val != nil {
+		return val
+	}
+	if val := parseGeneratedPtrs(ptr.Interface()); val != nil {
+		return val
+	}
+	if val := parseGeneratedArray(ptr.Elem()); val != nil {
+		return val
+	}
+	return parseTextValue(ptr.Elem())
+}
+
+// -- Map Value
+
+type mapValue struct {
+	value reflect.Value
+	seps  *separators
+	// values of keys, that were set, so slices are appended
+	// by repeated flags as slice values are.
+	elems map[interface{}]mapElem
+}
+
+type mapElem struct {
+	ptr reflect.Value
+	val Value
+}
+
+var _ RepeatableFlag = (*mapValue)(nil)
+var _ Value = (*mapValue)(nil)
+var _ Getter = (*mapValue)(nil)
+
+func (v *mapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *mapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+	key, err := v.parseKey(ss[0])
+	if err != nil {
+		return err
+	}
+	if v.elems == nil {
+		v.elems = map[interface{}]mapElem{}
+	}
+	elem, found := v.elems[key.Interface()]
+	if !found {
+		elem.ptr = reflect.New(v.value.Type().Elem())
+		elem.val = newElemValue(elem.ptr)
+		if sepsVal, casted := elem.val.(separatorsValue); casted {
+			sepsVal.setSeparators(v.seps)
+		}
+	}
+	if err := elem.val.Set(ss[1]); err != nil {
+		return err
+	}
+	v.elems[key.Interface()] = elem
+	v.value.SetMapIndex(key, elem.ptr.Elem())
+	return nil
+}
+
+// hasListValues checks that values of the map are slices or arrays,
+// which elements are split by separators.
+func (v *mapValue) hasListValues() bool {
+	_, isList := newElemValue(reflect.New(v.value.Type().Elem())).(separatorsValue)
+	return isList
+}
+
+func (v *mapValue) parseKey(s string) (reflect.Value, error) {
+	ptr := reflect.New(v.value.Type().Key())
+	val := newElemValue(ptr)
+	if val == nil {
+		return parseMapKey(ptr.Elem().Type(), s)
+	}
+	err := val.Set(s)
+	return ptr.Elem(), err
+}
+
+func (v *mapValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Interface()
+	}
+	return nil
+}
+
+func (v *mapValue) String() string {
+	if v == nil || !v.value.IsValid() || v.value.Len() == 0 {
+		return ""
+	}
+	out := make([]string, 0, v.value.Len())
+	iter := v.value.MapRange()
+	for iter.Next() {
+		out = append(out, formatElem(iter.Key())+":"+formatElem(iter.Value()))
+	}
+	sort.Strings(out)
+	return "map[" + strings.Join(out, " ") + "]"
+}
+
+func (v *mapValue) Type() string { return v.value.Type().String() }
+
+func (v *mapValue) IsCumulative() bool {
+	return true
+}
+
+// formatElem formats a key or a value of a map by its Value.
+func formatElem(elem reflect.Value) string {
+	ptr := reflect.New(elem.Type())
+	ptr.Elem().Set(elem)
+	if val := newElemValue(ptr); val != nil {
+		return val.String()
+	}
+	return fmt.Sprint(elem.Interface())
+}
+
+// parseMapKey parses s as a map key of typ, that has one of MapAllowedKinds.
+func parseMapKey(typ reflect.Type, s string) (reflect.Value, error) {
+	key := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		key.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(s, 0, typ.Bits())
+		if err != nil {
+			return key, err
+		}
+		key.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(s, 0, typ.Bits())
+		if err != nil {
+			return key, err
+		}
+		key.SetUint(parsed)
+	default:
+		return key, fmt.Errorf("unsupported map key type %s", typ)
+	}
+	return key, nil
+}
@@ -0,0 +1,66 @@
+package sflags
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStruct_Maps(t *testing.T) {
+	cfg := &struct {
+		Labels   map[string][]string `default:"env:dev"`
+		Timeouts map[string]time.Duration
+		Retries  map[time.Duration]int
+		Hosts    map[netip.Addr]string
+		Ports    map[string][]int `kvsep:"=" sep:";"`
+		Enabled  map[bool]string
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 6)
+	assert.Equal(t, "map[env:[dev]]", flags[0].DefValue)
+	assert.Equal(t, "map[string][]string", flags[0].Value.Type())
+
+	require.NoError(t, flags[0].Value.Set("env:prod,staging"))
+	require.NoError(t, flags[0].Value.Set("env:qa"))
+	require.NoError(t, flags[0].Value.Set("team:core"))
+	assert.Equal(t, map[string][]string{"env": {"prod", "staging", "qa"}, "team": {"core"}}, cfg.Labels)
+	assert.Equal(t, "map[env:[prod,staging,qa] team:[core]]", flags[0].Value.String())
+
+	require.NoError(t, flags[1].Value.Set(`"db:5432":5s`))
+	require.NoError(t, flags[1].Value.Set(`cache:1m`))
+	assert.Equal(t, map[string]time.Duration{"db:5432": 5 * time.Second, "cache": time.Minute}, cfg.Timeouts)
+	assert.Equal(t, "map[cache:1m0s db:5432:5s]", flags[1].Value.String())
+
+	require.NoError(t, flags[2].Value.Set("1s:3"))
+	assert.Equal(t, map[time.Duration]int{time.Second: 3}, cfg.Retries)
+	assert.Error(t, flags[2].Value.Set("1x:3"))
+
+	require.NoError(t, flags[3].Value.Set(`"::1":localhost`))
+	require.NoError(t, flags[3].Value.Set(`10.0.0.1:gateway`))
+	assert.Equal(t, map[netip.Addr]string{
+		netip.MustParseAddr("::1"):      "localhost",
+		netip.MustParseAddr("10.0.0.1"): "gateway",
+	}, cfg.Hosts)
+	assert.EqualError(t, flags[3].Value.Set(`"::1:localhost`), `unterminated quoted value in "\"::1:localhost"`)
+	assert.EqualError(t, flags[3].Value.Set(`"::1"localhost`), "invalid map flag syntax, use -map=key1:val1")
+
+	require.NoError(t, flags[4].Value.Set("http=80;8080"))
+	assert.Equal(t, map[string][]int{"http": {80, 8080}}, cfg.Ports)
+	assert.Error(t, flags[4].Value.Set("http=x"))
+
+	require.NoError(t, flags[5].Value.Set("true:on"))
+	assert.Equal(t, map[bool]string{true: "on"}, cfg.Enabled)
+}
+
+func TestMapValue_Zero(t *testing.T) {
+	nilValue := new(mapValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*mapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
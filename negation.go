@@ -0,0 +1,68 @@
+package sflags
+
+import "strconv"
+
+// negationPrefix is prepended to a name of a negatable flag.
+const negationPrefix = "no-"
+
+// Negation returns a hidden --no-<name> counterpart of a negatable boolean flag,
+// that sets false to the flag, or nil, if the flag isn't negatable.
+// Generators register it next to the flag and mention it in usage
+// of the flag by NegationUsage, so it's shown in help once.
+func (f *Flag) Negation() *Flag {
+	if !f.Negatable {
+		return nil
+	}
+	return &Flag{
+		Name:       negationPrefix + f.Name,
+		Usage:      "negates --" + f.Name,
+		Value:      &negatedValue{value: f.Value},
+		DefValue:   "false",
+		Hidden:     true,
+		Deprecated: f.Deprecated,
+	}
+}
+
+// NegationUsage returns usage with a hint about the negation of a negatable
+// flag appended, e.g. "colorize output (use --no-color to disable)".
+// It returns usage as is, if flag isn't negatable.
+func NegationUsage(flag *Flag, usage string) string {
+	if !flag.Negatable {
+		return usage
+	}
+	negation := "(use --" + negationPrefix + flag.Name + " to disable)"
+	if usage == "" {
+		return negation
+	}
+	return usage + " " + negation
+}
+
+// negatedValue sets the opposite boolean value to value.
+type negatedValue struct {
+	value Value
+}
+
+var _ BoolFlag = (*negatedValue)(nil)
+
+func (v *negatedValue) Set(s string) error {
+	parsed, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	return v.value.Set(strconv.FormatBool(!parsed))
+}
+
+func (v *negatedValue) String() string {
+	if v == nil || v.value == nil {
+		return ""
+	}
+	parsed, err := strconv.ParseBool(v.value.String())
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatBool(!parsed)
+}
+
+func (v *negatedValue) Type() string { return "bool" }
+
+func (v *negatedValue) IsBoolFlag() bool { return true }
@@ -0,0 +1,50 @@
+package sflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStruct_Negatable(t *testing.T) {
+	cfg := &struct {
+		Color   bool `flag:",negatable" desc:"colorize output"`
+		Verbose bool `flag:",deprecated"`
+	}{Color: true}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+	assert.True(t, flags[0].Negatable)
+	assert.Nil(t, flags[1].Negation())
+	assert.Equal(t, "(use --no-color to disable)", NegationUsage(flags[0], ""))
+	assert.Equal(t, "colorize output (use --no-color to disable)", NegationUsage(flags[0], flags[0].Usage))
+	assert.Equal(t, "verbose", NegationUsage(flags[1], "verbose"))
+
+	negation := flags[0].Negation()
+	require.NotNil(t, negation)
+	assert.Equal(t, "no-color", negation.Name)
+	assert.Equal(t, "negates --color", negation.Usage)
+	assert.Equal(t, "false", negation.DefValue)
+	assert.True(t, negation.Hidden)
+	assert.Equal(t, "false", negation.Value.String())
+	assert.True(t, negation.Value.(BoolFlag).IsBoolFlag())
+
+	require.NoError(t, negation.Value.Set("true"))
+	assert.False(t, cfg.Color)
+	assert.Equal(t, "true", negation.Value.String())
+	require.NoError(t, negation.Value.Set("false"))
+	assert.True(t, cfg.Color)
+	assert.Error(t, negation.Value.Set("x"))
+
+	flags, err = ParseStruct(cfg, Negatable())
+	require.NoError(t, err)
+	assert.True(t, flags[1].Negatable)
+	assert.Equal(t, "no-verbose", flags[1].Negation().Name)
+	assert.True(t, flags[1].Negation().Deprecated)
+
+	_, err = ParseStruct(&struct {
+		Count int `flag:",negatable"`
+	}{})
+	assert.EqualError(t, err, "field Count: negatable is not supported for int")
+}
@@ -2,7 +2,10 @@ package sflags
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -10,6 +13,20 @@ const (
 	defaultDescTag           = "desc"
 	defaultFlagTag           = "flag"
 	defaultEnvTag            = "env"
+	defaultValueTag          = "default"
+	defaultChoicesTag        = "choices"
+	defaultPlaceholderTag    = "placeholder"
+	defaultSecretTag         = "secret"
+	defaultEnvFileTag        = "envfile"
+	defaultSchemesTag        = "schemes"
+	defaultLayoutTag         = "layout"
+	defaultGroupTag          = "group"
+	defaultSepTag            = "sep"
+	defaultKVSepTag          = "kvsep"
+	defaultSizeTag           = "size"
+	defaultKeysTag           = "keys"
+	defaultArgTag            = "arg"
+	defaultCmdTag            = "cmd"
 	defaultFlagDivider       = "-"
 	defaultEnvDivider        = "_"
 	defaultFlatten           = true
@@ -22,6 +39,8 @@ const (
 // ValidateFunc describes a validation func,
 // that takes string val for flag from command line,
 // field that's associated with this flag in structure cfg.
+// `sep` and `kvsep` tags of field are set to separators of slices
+// and maps, that are used for the flag, see Separator and KVSeparator.
 // Should return error if validation fails.
 type ValidateFunc func(val string, field reflect.StructField, cfg interface{}) error
 
@@ -38,6 +57,17 @@ type opts struct {
 	hidden            bool
 	inheritDeprecated bool
 	deprecated        bool
+	trackSource       bool
+	envFile           bool
+	absoluteURL       bool
+	groups            []Group
+	separators        separators
+	discover          []string
+	negatable         bool
+	onSet             func()
+	// element types of slices and maps of structures, which flag names
+	// are being discovered, so recursive types are discovered once.
+	discovering []reflect.Type
 }
 
 func (o opts) apply(optFuncs ...OptFunc) opts {
@@ -67,6 +97,24 @@ func hidden(val bool) OptFunc {
 	return func(opt *opts) { opt.hidden = val }
 }
 
+// groups adds groups of a parent structure to all nested flags.
+func groups(val []Group) OptFunc {
+	return func(opt *opts) { opt.groups = val }
+}
+
+// onSet sets a function, that is called after every Set of nested flags,
+// e.g. to store an element of a map of structures back.
+func onSet(val func()) OptFunc {
+	return func(opt *opts) { opt.onSet = val }
+}
+
+// discovering adds an element type, which flag names are being discovered.
+func discovering(typ reflect.Type) OptFunc {
+	return func(opt *opts) {
+		opt.discovering = append(opt.discovering[:len(opt.discovering):len(opt.discovering)], typ)
+	}
+}
+
 // InheritDeprecated enables inheriting the deprecated flag for all nested flags if set for a parent flag
 func InheritDeprecated() OptFunc { return func(opt *opts) { opt.inheritDeprecated = true } }
 
@@ -89,6 +137,45 @@ func EnvDivider(val string) OptFunc { return func(opt *opts) { opt.envDivider =
 // Check existed validators in sflags/validator package.
 func Validator(val ValidateFunc) OptFunc { return func(opt *opts) { opt.validator = val } }
 
+// TrackSource enables tracking of where flag values came from, see Flag.Source.
+func TrackSource() OptFunc { return func(opt *opts) { opt.trackSource = true } }
+
+// EnvFile enables reading values from files, which paths are set in environment
+// variables with "_FILE" suffix, e.g. DB_PASSWORD_FILE=/run/secrets/db.
+// It might be enabled for a single field by `envfile:"true"` tag.
+func EnvFile() OptFunc { return func(opt *opts) { opt.envFile = true } }
+
+// RequireAbsoluteURL enables checking that url.URL values are absolute, e.g. "https://example.com".
+func RequireAbsoluteURL() OptFunc { return func(opt *opts) { opt.absoluteURL = true } }
+
+// Separator sets separator of slice elements, that are passed
+// in a single command line value. It is comma by default.
+// Empty separator means that every value is a single element.
+// It might be set for a single field by `sep` tag.
+func Separator(val string) OptFunc { return func(opt *opts) { opt.separators.elem = val } }
+
+// KVSeparator sets separator of a key and a value of maps. It is colon by default.
+// It might be set for a single field by `kvsep` tag.
+// Empty separator is ignored.
+func KVSeparator(val string) OptFunc {
+	return func(opt *opts) {
+		if val != "" {
+			opt.separators.kv = val
+		}
+	}
+}
+
+// DiscoverFlags adds flag names, that elements of slices and maps
+// of structures are discovered from, e.g. "upstream-1-host" adds
+// the second element of Upstream field. It's used for keys of config files.
+func DiscoverFlags(names ...string) OptFunc {
+	return func(opt *opts) { opt.discover = append(opt.discover[:len(opt.discover):len(opt.discover)], names...) }
+}
+
+// Negatable makes all boolean flags negatable, so --no-<name> sets them to false.
+// It might be set for a single field by `flag:"name,negatable"` tag.
+func Negatable() OptFunc { return func(opt *opts) { opt.negatable = true } }
+
 // Flatten set flatten option.
 // Set to false if you don't want anonymous structure fields to be flatten.
 func Flatten(val bool) OptFunc { return func(opt *opts) { opt.flatten = val } }
@@ -115,6 +202,7 @@ func defOpts() opts {
 		hidden:            defaultHidden,
 		inheritDeprecated: defaultInheritDeprecated,
 		deprecated:        defaultDeprecated,
+		separators:        defaultSeparators,
 	}
 }
 
@@ -143,6 +231,8 @@ func parseFlagTag(field reflect.StructField, opt opts) *Flag {
 		flag.Hidden = hasOption(flagTags[1:], "hidden")
 		flag.Deprecated = hasOption(flagTags[1:], "deprecated")
 		flag.Required = hasOption(flagTags[1:], "required")
+		flag.Secret = hasOption(flagTags[1:], "secret")
+		flag.Negatable = hasOption(flagTags[1:], "negatable")
 	}
 
 	if opt.prefix != "" && !ignoreFlagPrefix {
@@ -201,9 +291,59 @@ func parseEnv(flagName string, field reflect.StructField, opt opts) []string {
 	return envVars
 }
 
+func parseArgTag(tag string, field reflect.StructField, opt opts) *Arg {
+	arg := Arg{}
+	arg.Name = camelToFlag(field.Name, opt.flagDivider)
+	argTags := strings.Split(tag, ",")
+	if argTags[0] != "" {
+		arg.Name = argTags[0]
+	}
+	arg.Required = hasOption(argTags[1:], "required")
+	return &arg
+}
+
+func parseCmdTag(tag string, field reflect.StructField, opt opts) *Command {
+	cmd := Command{}
+	cmd.Name = camelToFlag(field.Name, opt.flagDivider)
+	cmdTags := strings.Split(tag, ",")
+	if cmdTags[0] != "" {
+		cmd.Name = cmdTags[0]
+	}
+	cmd.Hidden = hasOption(cmdTags[1:], "hidden")
+	return &cmd
+}
+
+// FlagName joins names of nested structures and a field, e.g. ["http", "host"],
+// to a flag name, e.g. "http-host", the same way as ParseStruct does with the same optFuncs.
+func FlagName(path []string, optFuncs ...OptFunc) string {
+	opt := defOpts().apply(optFuncs...)
+	return opt.prefix + strings.Join(path, opt.flagDivider)
+}
+
 // ParseStruct parses structure and returns list of flags based on this structure.
 // This list of flags can be used by generators for flag, kingpin, cobra, pflag, urfave/cli.
 func ParseStruct(cfg interface{}, optFuncs ...OptFunc) ([]*Flag, error) {
+	cmd, err := ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	return cmd.Flags, nil
+}
+
+// ParseStructWithArgs parses structure and returns list of flags and
+// list of positional arguments (fields with `arg` tag) in declaration order.
+func ParseStructWithArgs(cfg interface{}, optFuncs ...OptFunc) ([]*Flag, []*Arg, error) {
+	cmd, err := ParseCommand(cfg, optFuncs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cmd.Flags, cmd.Args, nil
+}
+
+// ParseCommand parses structure and returns a root command with flags,
+// positional arguments and subcommands (fields with `cmd` tag) based on this structure.
+// Every subcommand is parsed from its own structure, so its flags aren't prefixed.
+func ParseCommand(cfg interface{}, optFuncs ...OptFunc) (*Command, error) {
 	// what we want is Ptr to Structure
 	if cfg == nil {
 		return nil, errors.New("object cannot be nil")
@@ -217,23 +357,51 @@ func ParseStruct(cfg interface{}, optFuncs ...OptFunc) ([]*Flag, error) {
 	}
 	switch e := v.Elem(); e.Kind() {
 	case reflect.Struct:
-		return parseStruct(e, optFuncs...), nil
+		cmd, err := parseStruct(e, optFuncs...)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkArgs(cmd); err != nil {
+			return nil, err
+		}
+		return cmd, nil
 	default:
 		return nil, errors.New("object must be a pointer to struct or interface")
 	}
 }
 
-func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value) {
+func checkArgs(cmd *Command) error {
+	for i, arg := range cmd.Args {
+		if arg.Variadic && i != len(cmd.Args)-1 {
+			return fmt.Errorf("variadic argument %s must be the last one", arg.Name)
+		}
+		if arg.Required && i > 0 && !cmd.Args[i-1].Required {
+			return fmt.Errorf("required argument %s can't follow optional argument %s", arg.Name, cmd.Args[i-1].Name)
+		}
+	}
+	for _, subCmd := range cmd.Commands {
+		if err := checkArgs(subCmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseVal(value reflect.Value, optFuncs ...OptFunc) (*Command, Value, error) {
 	// value is addressable, let's check if we can parse it
 	if value.CanAddr() && value.Addr().CanInterface() {
 		valueInterface := value.Addr().Interface()
 		val := parseGenerated(valueInterface)
 		if val != nil {
-			return nil, val
+			return nil, val, nil
 		}
 		// check if field implements Value interface
 		if val, casted := valueInterface.(Value); casted {
-			return nil, val
+			return nil, val, nil
+		}
+		// check if field implements encoding.TextUnmarshaler
+		if val := parseTextValue(value); val != nil {
+			return nil, val, nil
 		}
 	}
 
@@ -244,38 +412,47 @@ func parseVal(value reflect.Value, optFuncs ...OptFunc) ([]*Flag, Value) {
 		}
 		val := parseGeneratedPtrs(value.Addr().Interface())
 		if val != nil {
-			return nil, val
+			return nil, val, nil
 		}
 		return parseVal(value.Elem(), optFuncs...)
 	case reflect.Struct:
-		flags := parseStruct(value, optFuncs...)
-		return flags, nil
+		cmd, err := parseStruct(value, optFuncs...)
+		return cmd, nil, err
+	case reflect.Array:
+		if val := parseGeneratedArray(value); val != nil {
+			return nil, val, nil
+		}
 	case reflect.Map:
 		mapType := value.Type()
 		keyKind := value.Type().Key().Kind()
 
 		// check that map key is string or integer
-		if !anyOf(MapAllowedKinds, keyKind) {
-			break
-		}
+		if anyOf(MapAllowedKinds, keyKind) {
+			if value.IsNil() {
+				value.Set(reflect.MakeMap(mapType))
+			}
 
-		if value.IsNil() {
-			value.Set(reflect.MakeMap(mapType))
+			valueInterface := value.Addr().Interface()
+			val := parseGeneratedMap(valueInterface)
+			if val != nil {
+				return nil, val, nil
+			}
 		}
 
-		valueInterface := value.Addr().Interface()
-		val := parseGeneratedMap(valueInterface)
-		if val != nil {
-			return nil, val
+		// other keys and values, e.g. map[string][]string
+		if val := parseMapValue(value); val != nil {
+			return nil, val, nil
 		}
 	}
-	return nil, nil
+	return nil, nil, nil
 }
 
-func parseStruct(value reflect.Value, optFuncs ...OptFunc) []*Flag {
+// parseStruct parses value as a command.
+// Nested structures are parsed as commands too and merged into it.
+func parseStruct(value reflect.Value, optFuncs ...OptFunc) (*Command, error) {
 	opt := defOpts().apply(optFuncs...)
 
-	flags := []*Flag{}
+	cmd := &Command{Flags: []*Flag{}}
 
 	valueType := value.Type()
 fields:
@@ -293,13 +470,26 @@ fields:
 		}
 
 		flag.EnvNames = parseEnv(flag.Name, field, opt)
-		flag.Usage = field.Tag.Get(opt.descTag)
+		// the same convention as in flag library: "HTTP `HOST` to bind".
+		flag.Placeholder, flag.Usage = unquoteUsage(field.Tag.Get(opt.descTag))
+		if placeholder := field.Tag.Get(defaultPlaceholderTag); placeholder != "" {
+			flag.Placeholder = placeholder
+		}
+		if secret, _ := strconv.ParseBool(field.Tag.Get(defaultSecretTag)); secret {
+			flag.Secret = true
+		}
 		prefix := flag.Name + opt.flagDivider
 		if field.Anonymous && opt.flatten {
 			prefix = opt.prefix
 		}
 
-		nestedOpts := []OptFunc{copyOpts(opt), Prefix(prefix)}
+		tagGroups, err := parseGroupTag(field.Tag.Get(defaultGroupTag))
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		flag.Groups = append(opt.groups[:len(opt.groups):len(opt.groups)], tagGroups...)
+
+		nestedOpts := []OptFunc{copyOpts(opt), Prefix(prefix), groups(flag.Groups)}
 		if opt.inheritHidden {
 			nestedOpts = append(nestedOpts, hidden(flag.Hidden))
 		}
@@ -307,33 +497,251 @@ fields:
 			nestedOpts = append(nestedOpts, deprecated(flag.Deprecated))
 		}
 
-		nestedFlags, val := parseVal(fieldValue,
+		seps := opt.separators
+		sep, hasSep := field.Tag.Lookup(defaultSepTag)
+		if hasSep {
+			seps.elem = sep
+		}
+		kvSep := field.Tag.Get(defaultKVSepTag)
+		if kvSep != "" {
+			seps.kv = kvSep
+		}
+
+		var validateFuncs []func(string) error
+		if choices := field.Tag.Get(defaultChoicesTag); choices != "" {
+			flag.Choices = strings.Split(choices, ",")
+			validateChoices, err := choicesValidator(field.Type, flag.Choices, seps)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			validateFuncs = append(validateFuncs, validateChoices)
+		}
+		validateURL, err := urlValidator(field.Type, field.Tag.Get(defaultSchemesTag), opt.absoluteURL, seps)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		if validateURL != nil {
+			validateFuncs = append(validateFuncs, validateURL)
+		}
+
+		// default tag is applied only if field wasn't populated before parsing
+		defValue, hasDefValue := field.Tag.Lookup(defaultValueTag)
+		hasDefValue = hasDefValue && fieldValue.IsZero()
+
+		if cmdTag, isCmd := field.Tag.Lookup(defaultCmdTag); isCmd {
+			subCmd := parseCmdTag(cmdTag, field, opt)
+			// subcommand has its own namespace for flags
+			nestedCmd, _, err := parseVal(fieldValue, copyOpts(opt), Prefix(""))
+			if err != nil {
+				return nil, err
+			}
+			if nestedCmd == nil {
+				return nil, fmt.Errorf("field %s: subcommand must be a structure", field.Name)
+			}
+			subCmd.Usage = flag.Usage
+			subCmd.Flags = nestedCmd.Flags
+			subCmd.Args = nestedCmd.Args
+			subCmd.Commands = nestedCmd.Commands
+			cmd.Commands = append(cmd.Commands, subCmd)
+			continue fields
+		}
+
+		nestedCmd, val, err := parseVal(fieldValue,
 			nestedOpts...,
 		)
+		if err != nil {
+			return nil, err
+		}
+		if nestedCmd == nil && val == nil {
+			nestedCmd, err = parseElems(fieldValue, field, flag, prefix, opt, nestedOpts)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+		}
 
 		// field contains a simple value.
 		if val != nil {
-			if opt.validator != nil {
-				val = &validateValue{
-					Value: val,
-					validateFunc: func(val string) error {
-						return opt.validator(val, field, value.Interface())
+			layout := field.Tag.Get(defaultLayoutTag)
+			if _, casted := val.(layoutValue); layout != "" && !casted {
+				return nil, fmt.Errorf("field %s: layout is not supported for %s", field.Name, field.Type)
+			}
+			if _, isBool := val.(*boolValue); !isBool && flag.Negatable {
+				return nil, fmt.Errorf("field %s: negatable is not supported for %s", field.Name, field.Type)
+			} else if isBool && opt.negatable {
+				flag.Negatable = true
+			}
+			if _, casted := val.(separatorsValue); !casted && (hasSep || kvSep != "") {
+				return nil, fmt.Errorf("field %s: separators are not supported for %s", field.Name, field.Type)
+			} else if casted && seps != defaultSeparators {
+				flag.seps = &seps
+			}
+			wrapValue := func(val Value) Value {
+				if layoutVal, casted := val.(layoutValue); casted && layout != "" {
+					layoutVal.setLayout(layout)
+				}
+				// nil separators of a value are the default ones
+				if sepsVal, casted := val.(separatorsValue); casted && seps != defaultSeparators {
+					sepsVal.setSeparators(&seps)
+				}
+				if opt.onSet != nil {
+					val = &onSetValue{Value: val, onSet: opt.onSet}
+				}
+				for _, validateFunc := range validateFuncs {
+					val = &validateValue{
+						Value:        val,
+						validateFunc: validateFunc,
+					}
+				}
+				if opt.validator != nil {
+					validateField := withSeparatorTags(field, seps)
+					val = &validateValue{
+						Value: val,
+						validateFunc: func(val string) error {
+							return opt.validator(val, validateField, value.Interface())
+						},
+					}
+				}
+				return val
+			}
+			val = wrapValue(val)
+			src := Source{Kind: SourceDefault}
+			if hasDefValue {
+				if err := val.Set(defValue); err != nil {
+					return nil, fmt.Errorf("invalid default value %q for field %s: %w", defValue, field.Name, err)
+				}
+				// cumulative values append after the first Set,
+				// so command line should start from a fresh value.
+				_, val, _ = parseVal(fieldValue, nestedOpts...)
+				val = wrapValue(val)
+				src.Kind = SourceDefaultTag
+			}
+			_, isArg := field.Tag.Lookup(defaultArgTag)
+			envFile, _ := strconv.ParseBool(field.Tag.Get(defaultEnvFileTag))
+			flag.EnvFile = (opt.envFile || envFile) && !isArg
+			if opt.trackSource {
+				val = &sourceValue{
+					Value:  val,
+					source: src,
+					newValue: func() Value {
+						_, val, _ := parseVal(fieldValue, nestedOpts...)
+						return wrapValue(val)
 					},
 				}
 			}
+			if isArg {
+				arg := parseArgTag(field.Tag.Get(defaultArgTag), field, opt)
+				arg.Usage = flag.Usage
+				arg.Value = val
+				arg.DefValue = val.String()
+				if cumulativeFlag, casted := val.(RepeatableFlag); casted {
+					arg.Variadic = cumulativeFlag.IsCumulative()
+				}
+				cmd.Args = append(cmd.Args, arg)
+				continue fields
+			}
 			flag.Value = val
 			flag.DefValue = val.String()
-			flags = append(flags, flag)
+			cmd.Flags = append(cmd.Flags, flag)
 			continue fields
 		}
 		// field is a structure
-		if len(nestedFlags) > 0 {
-			flags = append(flags, nestedFlags...)
+		if nestedCmd != nil {
+			cmd.Flags = append(cmd.Flags, nestedCmd.Flags...)
+			cmd.Args = append(cmd.Args, nestedCmd.Args...)
+			cmd.Commands = append(cmd.Commands, nestedCmd.Commands...)
 			continue fields
 		}
 
 	}
-	return flags
+	return cmd, nil
+}
+
+// choicesValidator returns a function that checks that every element of
+// a raw command line value is one of choices.
+// Only strings, slices and maps of strings (map values are checked) are supported.
+func choicesValidator(typ reflect.Type, choices []string, seps separators) (func(string) error, error) {
+	split := elemSplitter(typ, seps, func(elem reflect.Type) bool {
+		return elem.Kind() == reflect.String
+	})
+	if split == nil {
+		return nil, fmt.Errorf("choices are not supported for %s", typ)
+	}
+	return func(s string) error {
+		for _, elem := range split(s) {
+			if !hasOption(choices, elem) {
+				return fmt.Errorf("invalid value %q, allowed values are: %s", elem, strings.Join(choices, ", "))
+			}
+		}
+		return nil
+	}, nil
+}
+
+// urlValidator returns a function that checks that every URL of
+// a raw command line value has one of schemes (a comma separated list)
+// and is absolute if requireAbs is set.
+// It returns nil if there is nothing to check for a field of typ.
+func urlValidator(typ reflect.Type, schemes string, requireAbs bool, seps separators) (func(string) error, error) {
+	split := elemSplitter(typ, seps, func(elem reflect.Type) bool {
+		return elem == reflect.TypeOf(url.URL{})
+	})
+	if split == nil {
+		if schemes != "" {
+			return nil, fmt.Errorf("schemes are not supported for %s", typ)
+		}
+		return nil, nil
+	}
+	if schemes == "" && !requireAbs {
+		return nil, nil
+	}
+	var allowed []string
+	if schemes != "" {
+		allowed = strings.Split(strings.ToLower(schemes), ",")
+	}
+	return func(s string) error {
+		for _, elem := range split(s) {
+			u, err := url.Parse(elem)
+			if err != nil {
+				// syntax errors are reported by url value itself
+				continue
+			}
+			if requireAbs && !u.IsAbs() {
+				return fmt.Errorf("invalid URL %q, it must be absolute", elem)
+			}
+			if len(allowed) > 0 && !hasOption(allowed, u.Scheme) {
+				return fmt.Errorf("invalid URL %q, allowed schemes are: %s", elem, strings.Join(allowed, ", "))
+			}
+		}
+		return nil
+	}, nil
+}
+
+// elemSplitter returns a function that splits a raw command line value
+// for a field of typ to raw elements, if typ is an element type, a slice
+// or a map (of values) of them. Element types are checked by isElem.
+func elemSplitter(typ reflect.Type, seps separators, isElem func(reflect.Type) bool) func(string) []string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch {
+	case isElem(typ):
+		return func(s string) []string { return []string{s} }
+	case (typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) && isElem(typ.Elem()):
+		return func(s string) []string {
+			// syntax errors are reported by slice value itself
+			elems, _ := seps.split(s)
+			return elems
+		}
+	case typ.Kind() == reflect.Map && isElem(typ.Elem()):
+		return func(s string) []string {
+			_, value, err := SplitKeyValue(s, seps.kv)
+			if err != nil {
+				// syntax errors are reported by map value itself
+				return nil
+			}
+			return []string{value}
+		}
+	}
+	return nil
 }
 
 func anyOf(kinds []reflect.Kind, needle reflect.Kind) bool {
@@ -3,9 +3,13 @@ package sflags
 import (
 	"errors"
 	"net"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -56,6 +60,7 @@ func TestParseStruct(t *testing.T) {
 		MapStringInt64   map[string]int64
 		MapStringString  map[string]string
 		MapBoolString    map[bool]string
+		MapStructString  map[struct{ X int }]string // will be ignored
 	}{
 		StringValue:      "string",
 		ByteValue:        10,
@@ -359,6 +364,12 @@ func TestParseStruct(t *testing.T) {
 					DefValue: "map[test:test-val]",
 					Value:    newStringStringMapValue(&diffTypesCfg.MapStringString),
 				},
+				{
+					Name:     "map-bool-string",
+					EnvNames: []string{"MAP_BOOL_STRING"},
+					DefValue: "",
+					Value:    &mapValue{value: reflect.ValueOf(&diffTypesCfg.MapBoolString).Elem()},
+				},
 			},
 		},
 		{
@@ -623,3 +634,193 @@ func TestFlatten(t *testing.T) {
 	Flatten(false)(&opt)
 	assert.Equal(t, false, opt.flatten)
 }
+
+func TestParseStruct_DefaultTag(t *testing.T) {
+	cfg := &struct {
+		Name    string            `default:"name_value"`
+		Name2   string            `default:"name2_value"`
+		Name3   *string           `default:"name3_value"`
+		Port    int               `default:"8080"`
+		Enabled bool              `default:"true"`
+		Timeout time.Duration     `default:"15s"`
+		Hosts   []string          `default:"one,two"`
+		Labels  map[string]string `default:"env:prod"`
+		Regexp  *regexp.Regexp    `default:"abc.*"`
+		Addr    net.TCPAddr       `default:"127.0.0.1:8000"`
+		Count   Counter           `default:"3"`
+		Empty   string
+	}{
+		Name2: "predefined",
+	}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 12, len(flags))
+
+	assert.Equal(t, "name_value", cfg.Name)
+	assert.Equal(t, "predefined", cfg.Name2)
+	assert.Equal(t, "name3_value", *cfg.Name3)
+	assert.Equal(t, 8080, cfg.Port)
+	assert.Equal(t, true, cfg.Enabled)
+	assert.Equal(t, 15*time.Second, cfg.Timeout)
+	assert.Equal(t, []string{"one", "two"}, cfg.Hosts)
+	assert.Equal(t, map[string]string{"env": "prod"}, cfg.Labels)
+	assert.Equal(t, "abc.*", cfg.Regexp.String())
+	assert.Equal(t, "127.0.0.1:8000", cfg.Addr.String())
+	assert.Equal(t, Counter(3), cfg.Count)
+
+	expDefValues := []string{
+		"name_value", "predefined", "name3_value", "8080", "true", "15s",
+		"[one,two]", "map[env:prod]", "abc.*", "127.0.0.1:8000", "3", "",
+	}
+	for i, flag := range flags {
+		assert.Equal(t, expDefValues[i], flag.DefValue, flag.Name)
+	}
+
+	// slice from default tag should be replaced, not appended
+	require.NoError(t, flags[6].Value.Set("three"))
+	assert.Equal(t, []string{"three"}, cfg.Hosts)
+}
+
+func TestParseStruct_DefaultTagError(t *testing.T) {
+	cfg := &struct {
+		Sub struct {
+			Port int `default:"port"`
+		}
+	}{}
+	_, err := ParseStruct(cfg)
+	require.EqualError(t, err,
+		`invalid default value "port" for field Port: strconv.ParseInt: parsing "port": invalid syntax`)
+}
+
+func TestParseStruct_ChoicesTag(t *testing.T) {
+	cfg := &struct {
+		Format  string            `choices:"json,yaml,text" default:"json"`
+		Formats []string          `choices:"json,yaml"`
+		Outputs map[string]string `choices:"json,yaml"`
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(flags))
+	assert.Equal(t, []string{"json", "yaml", "text"}, flags[0].Choices)
+	assert.Equal(t, "json", cfg.Format)
+
+	assert.NoError(t, flags[0].Value.Set("yaml"))
+	assert.EqualError(t, flags[0].Value.Set("xml"),
+		`invalid value "xml", allowed values are: json, yaml, text`)
+	assert.Equal(t, "yaml", cfg.Format)
+
+	assert.NoError(t, flags[1].Value.Set("json,yaml"))
+	assert.EqualError(t, flags[1].Value.Set("json,xml"),
+		`invalid value "xml", allowed values are: json, yaml`)
+	assert.Equal(t, []string{"json", "yaml"}, cfg.Formats)
+
+	assert.NoError(t, flags[2].Value.Set("stdout:json"))
+	assert.EqualError(t, flags[2].Value.Set("stderr:xml"),
+		`invalid value "xml", allowed values are: json, yaml`)
+	assert.EqualError(t, flags[2].Value.Set("stderr"),
+		"invalid map flag syntax, use -map=key1:val1")
+	assert.Equal(t, map[string]string{"stdout": "json"}, cfg.Outputs)
+}
+
+func TestParseStruct_ChoicesTagError(t *testing.T) {
+	_, err := ParseStruct(&struct {
+		Format string `choices:"json,yaml" default:"xml"`
+	}{})
+	require.EqualError(t, err,
+		`invalid default value "xml" for field Format: invalid value "xml", allowed values are: json, yaml`)
+
+	_, err = ParseStruct(&struct {
+		Port int `choices:"80,443"`
+	}{})
+	require.EqualError(t, err, "field Port: choices are not supported for int")
+}
+
+func TestParseStruct_PlaceholderTag(t *testing.T) {
+	cfg := &struct {
+		Host   string `placeholder:"HOST" desc:"http host"`
+		Output string `desc:"write result to \x60FILE\x60"`
+		Input  string `placeholder:"PATH" desc:"read \x60FILE\x60"`
+		Port   int
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(flags))
+	assert.Equal(t, "HOST", flags[0].Placeholder)
+	assert.Equal(t, "http host", flags[0].Usage)
+	assert.Equal(t, "FILE", flags[1].Placeholder)
+	assert.Equal(t, "write result to FILE", flags[1].Usage)
+	assert.Equal(t, "PATH", flags[2].Placeholder)
+	assert.Equal(t, "read FILE", flags[2].Usage)
+	assert.Equal(t, "", flags[3].Placeholder)
+}
+
+func TestFlagName(t *testing.T) {
+	assert.Equal(t, "http-host", FlagName([]string{"http", "host"}))
+	assert.Equal(t, "host", FlagName([]string{"host"}))
+	assert.Equal(t, "app.http.host", FlagName([]string{"http", "host"}, FlagDivider("."), Prefix("app.")))
+}
+
+func TestParseStruct_EnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(path, []byte("qwerty\n"), 0o600))
+	t.Setenv("PASSWORD_FILE", path)
+
+	type config struct {
+		Password string `flag:",required" secret:"true"`
+		Tags     []string
+	}
+	cfg := &config{}
+	flags, err := ParseStruct(cfg, EnvFile())
+	require.NoError(t, err)
+	// files are read by generators with environment variables,
+	// so they don't take precedence over config files and don't leak to help.
+	assert.Equal(t, &config{}, cfg)
+	assert.True(t, flags[0].EnvFile)
+	assert.True(t, flags[0].Required)
+	assert.Equal(t, "", flags[0].DefValue)
+	assert.True(t, flags[1].EnvFile)
+
+	cfg2 := &struct {
+		Password string `envfile:"true"`
+		User     string
+	}{}
+	flags, err = ParseStruct(cfg2)
+	require.NoError(t, err)
+	assert.True(t, flags[0].EnvFile)
+	assert.False(t, flags[1].EnvFile)
+}
+
+func TestParseStruct_URL(t *testing.T) {
+	cfg := &struct {
+		Endpoint  url.URL  `schemes:"http,https"`
+		Proxy     *url.URL `default:"http://proxy:3128"`
+		Mirrors   []url.URL
+		Upstreams map[string]url.URL `schemes:"https"`
+	}{}
+	flags, err := ParseStruct(cfg, RequireAbsoluteURL())
+	require.NoError(t, err)
+	require.Equal(t, 4, len(flags))
+	assert.Equal(t, "http://proxy:3128", cfg.Proxy.String())
+
+	require.NoError(t, flags[0].Value.Set("HTTPS://example.com/api"))
+	assert.Equal(t, "https://example.com/api", cfg.Endpoint.String())
+	assert.EqualError(t, flags[0].Value.Set("ftp://example.com"),
+		`invalid URL "ftp://example.com", allowed schemes are: http, https`)
+	assert.EqualError(t, flags[0].Value.Set("/api"), `invalid URL "/api", it must be absolute`)
+	assert.EqualError(t, flags[0].Value.Set("://example.com"), `parse "://example.com": missing protocol scheme`)
+
+	require.NoError(t, flags[2].Value.Set("ftp://a.com,http://b.com"))
+	assert.EqualError(t, flags[2].Value.Set("ftp://a.com,b.com"), `invalid URL "b.com", it must be absolute`)
+	require.Equal(t, 2, len(cfg.Mirrors))
+
+	require.NoError(t, flags[3].Value.Set("main:https://a.com"))
+	assert.EqualError(t, flags[3].Value.Set("backup:http://b.com"),
+		`invalid URL "http://b.com", allowed schemes are: https`)
+	upstream := cfg.Upstreams["main"]
+	assert.Equal(t, "https://a.com", upstream.String())
+
+	_, err = ParseStruct(&struct {
+		Endpoint string `schemes:"http"`
+	}{})
+	assert.EqualError(t, err, "field Endpoint: schemes are not supported for string")
+}
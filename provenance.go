@@ -0,0 +1,140 @@
+package sflags
+
+// SourceKind describes what set a flag value.
+type SourceKind int
+
+// Kinds of flag value sources.
+const (
+	SourceDefault    SourceKind = iota // value of the field before parsing
+	SourceDefaultTag                   // value from default tag
+	SourceEnv                          // environment variable
+	SourceFile                         // config file
+	SourceCLI                          // command line argument
+)
+
+// String returns a name of the kind, e.g. "env".
+func (k SourceKind) String() string {
+	switch k {
+	case SourceDefault:
+		return "default"
+	case SourceDefaultTag:
+		return "default tag"
+	case SourceEnv:
+		return "env"
+	case SourceFile:
+		return "file"
+	case SourceCLI:
+		return "cli"
+	}
+	return "unknown"
+}
+
+// Source describes where a flag value came from.
+type Source struct {
+	Kind SourceKind
+	Name string // optional name of environment variable or config file
+}
+
+// String returns a human readable source, e.g. "env HTTP_HOST".
+func (s Source) String() string {
+	if s.Name == "" {
+		return s.Kind.String()
+	}
+	return s.Kind.String() + " " + s.Name
+}
+
+// Source returns where the flag value came from.
+// Sources are tracked only if flags are parsed with TrackSource option,
+// otherwise it's always SourceDefault.
+func (f *Flag) Source() Source {
+	if v, casted := f.Value.(*sourceValue); casted {
+		return v.source
+	}
+	return Source{Kind: SourceDefault}
+}
+
+// SetSource records src as the source of the flag value.
+// Use it when the value was set by a library, e.g. from environment variable,
+// because every Value.Set call is treated as set from command line.
+func (f *Flag) SetSource(src Source) {
+	if v, casted := f.Value.(*sourceValue); casted {
+		v.source = src
+	}
+}
+
+// SetFrom sets val to the flag value and records src as its source.
+func (f *Flag) SetFrom(val string, src Source) error {
+	if v, casted := f.Value.(*sourceValue); casted {
+		return v.setFrom(val, src)
+	}
+	return f.Value.Set(val)
+}
+
+// Changed returns flags, which values weren't set from defaults:
+// by environment variables, config files or command line.
+// It requires TrackSource option.
+func Changed(flags []*Flag) []*Flag {
+	var changed []*Flag
+	for _, flag := range flags {
+		if kind := flag.Source().Kind; kind != SourceDefault && kind != SourceDefaultTag {
+			changed = append(changed, flag)
+		}
+	}
+	return changed
+}
+
+// sourceValue records where its value came from.
+// Set is treated as set from command line.
+type sourceValue struct {
+	Value
+	source Source
+	// newValue creates a fresh value for the same field, it's used to
+	// start cumulative values from scratch, when other source sets them.
+	newValue func() Value
+}
+
+func (v *sourceValue) IsBoolFlag() bool {
+	if boolFlag, casted := v.Value.(BoolFlag); casted {
+		return boolFlag.IsBoolFlag()
+	}
+	return false
+}
+
+func (v *sourceValue) IsCumulative() bool {
+	if cumulativeFlag, casted := v.Value.(RepeatableFlag); casted {
+		return cumulativeFlag.IsCumulative()
+	}
+	return false
+}
+
+func (v *sourceValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+	return nil
+}
+
+func (v *sourceValue) unwrapValue() Value { return v.Value }
+
+func (v *sourceValue) String() string {
+	if v == nil || v.Value == nil {
+		return ""
+	}
+	return v.Value.String()
+}
+
+func (v *sourceValue) Set(val string) error {
+	return v.setFrom(val, Source{Kind: SourceCLI})
+}
+
+func (v *sourceValue) setFrom(val string, src Source) error {
+	if src != v.source && v.newValue != nil && v.IsCumulative() {
+		// e.g. command line replaces a list from config file instead of appending to it.
+		v.Value = v.newValue()
+	}
+	if err := v.Value.Set(val); err != nil {
+		return err
+	}
+	v.source = src
+	return nil
+}
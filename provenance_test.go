@@ -0,0 +1,69 @@
+package sflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackSource(t *testing.T) {
+	cfg := &struct {
+		Host  string
+		Port  int      `default:"8080"`
+		Tags  []string `default:"a,b"`
+		Debug bool
+	}{Host: "localhost"}
+	flags, err := ParseStruct(cfg, TrackSource())
+	require.NoError(t, err)
+	require.Equal(t, 4, len(flags))
+	assert.Equal(t, Source{Kind: SourceDefault}, flags[0].Source())
+	assert.Equal(t, Source{Kind: SourceDefaultTag}, flags[1].Source())
+	assert.Equal(t, Source{Kind: SourceDefaultTag}, flags[2].Source())
+	assert.Empty(t, Changed(flags))
+
+	require.NoError(t, flags[0].Value.Set("example.com"))
+	assert.Equal(t, Source{Kind: SourceCLI}, flags[0].Source())
+
+	envSource := Source{Kind: SourceEnv, Name: "PORT"}
+	require.NoError(t, flags[1].SetFrom("9000", envSource))
+	assert.Equal(t, envSource, flags[1].Source())
+	assert.Equal(t, 9000, cfg.Port)
+	assert.Error(t, flags[1].SetFrom("port", Source{Kind: SourceCLI}))
+	assert.Equal(t, envSource, flags[1].Source())
+
+	fileSource := Source{Kind: SourceFile, Name: "config.yaml"}
+	require.NoError(t, flags[2].SetFrom("c", fileSource))
+	require.NoError(t, flags[2].SetFrom("d", fileSource))
+	assert.Equal(t, []string{"c", "d"}, cfg.Tags)
+	// command line replaces values from other sources
+	require.NoError(t, flags[2].Value.Set("e"))
+	require.NoError(t, flags[2].Value.Set("f"))
+	assert.Equal(t, []string{"e", "f"}, cfg.Tags)
+	assert.Equal(t, Source{Kind: SourceCLI}, flags[2].Source())
+
+	flags[3].SetSource(Source{Kind: SourceEnv, Name: "DEBUG"})
+	assert.Equal(t, []*Flag{flags[0], flags[1], flags[2], flags[3]}, Changed(flags))
+}
+
+func TestSourceWithoutTracking(t *testing.T) {
+	cfg := &struct {
+		Port int `default:"8080"`
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.NoError(t, flags[0].SetFrom("9000", Source{Kind: SourceEnv, Name: "PORT"}))
+	flags[0].SetSource(Source{Kind: SourceEnv, Name: "PORT"})
+	assert.Equal(t, 9000, cfg.Port)
+	assert.Equal(t, Source{Kind: SourceDefault}, flags[0].Source())
+	assert.Empty(t, Changed(flags))
+}
+
+func TestSourceString(t *testing.T) {
+	assert.Equal(t, "default", Source{}.String())
+	assert.Equal(t, "default tag", Source{Kind: SourceDefaultTag}.String())
+	assert.Equal(t, "env HTTP_HOST", Source{Kind: SourceEnv, Name: "HTTP_HOST"}.String())
+	assert.Equal(t, "file config.yaml", Source{Kind: SourceFile, Name: "config.yaml"}.String())
+	assert.Equal(t, "cli", Source{Kind: SourceCLI}.String())
+	assert.Equal(t, "unknown", SourceKind(-1).String())
+}
@@ -0,0 +1,169 @@
+package sflags
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// separators split raw command line values of slices and maps.
+// They are set by `sep` and `kvsep` tags or by Separator and KVSeparator options.
+type separators struct {
+	elem string // separator of slice elements, empty means no split
+	kv   string // separator of a map key and value
+}
+
+var defaultSeparators = separators{elem: ",", kv: ":"}
+
+// separatorsValue is implemented by slice and map values.
+type separatorsValue interface {
+	setSeparators(seps *separators)
+}
+
+// split splits raw value of a slice, nil separators are the default ones.
+func (s *separators) split(raw string) ([]string, error) {
+	if s == nil {
+		s = &defaultSeparators
+	}
+	return SplitValues(raw, s.elem)
+}
+
+// splitKV splits raw value of a map to a key and a value,
+// nil separators are the default ones.
+func (s *separators) splitKV(raw string) ([]string, error) {
+	if s == nil {
+		s = &defaultSeparators
+	}
+	key, value, err := SplitKeyValue(raw, s.kv)
+	if err != nil {
+		return nil, err
+	}
+	return []string{key, value}, nil
+}
+
+// withSeparatorTags returns field with `sep` and `kvsep` tags set to seps,
+// unless the field has them, so validators split values the same way.
+func withSeparatorTags(field reflect.StructField, seps separators) reflect.StructField {
+	if _, found := field.Tag.Lookup(defaultSepTag); !found {
+		field.Tag += reflect.StructTag(" " + defaultSepTag + ":" + strconv.Quote(seps.elem))
+	}
+	if _, found := field.Tag.Lookup(defaultKVSepTag); !found {
+		field.Tag += reflect.StructTag(" " + defaultKVSepTag + ":" + strconv.Quote(seps.kv))
+	}
+	return field
+}
+
+// SplitKeyValue splits raw value of a map to a key and a value by sep.
+// The key might be quoted as in CSV to contain sep, e.g. `"host:80":5s`.
+func SplitKeyValue(raw, sep string) (key, value string, err error) {
+	if strings.HasPrefix(raw, `"`) {
+		key, rest, err := cutQuoted(raw)
+		if err != nil {
+			return "", "", err
+		}
+		if !strings.HasPrefix(rest, sep) {
+			return "", "", fmt.Errorf("invalid map flag syntax, use -map=key1%sval1", sep)
+		}
+		return key, rest[len(sep):], nil
+	}
+	key, value, found := strings.Cut(raw, sep)
+	if !found {
+		return "", "", fmt.Errorf("invalid map flag syntax, use -map=key1%sval1", sep)
+	}
+	return key, value, nil
+}
+
+// SplitValues splits raw value by sep, empty sep means no split.
+// Elements might be quoted as in CSV: `"a,b",c` is split to "a,b" and "c",
+// quotes are escaped by doubling them in quoted elements.
+func SplitValues(raw, sep string) ([]string, error) {
+	if sep == "" {
+		return []string{raw}, nil
+	}
+	if !strings.Contains(raw, `"`) {
+		return strings.Split(raw, sep), nil
+	}
+	var out []string
+	rest := raw
+	for {
+		if !strings.HasPrefix(rest, `"`) {
+			elem, tail, found := strings.Cut(rest, sep)
+			out = append(out, elem)
+			if !found {
+				return out, nil
+			}
+			rest = tail
+			continue
+		}
+		elem, tail, err := cutQuoted(rest)
+		if err != nil {
+			return nil, err
+		}
+		rest = tail
+		out = append(out, elem)
+		if rest == "" {
+			return out, nil
+		}
+		if !strings.HasPrefix(rest, sep) {
+			return nil, fmt.Errorf("unexpected characters after quoted value in %q", raw)
+		}
+		rest = rest[len(sep):]
+	}
+}
+
+// cutQuoted cuts a quoted value from the beginning of raw,
+// doubled quotes are unescaped.
+func cutQuoted(raw string) (elem, rest string, err error) {
+	var b strings.Builder
+	rest = raw[1:]
+	for {
+		end := strings.IndexByte(rest, '"')
+		if end < 0 {
+			return "", "", fmt.Errorf("unterminated quoted value in %q", raw)
+		}
+		b.WriteString(rest[:end])
+		rest = rest[end+1:]
+		if !strings.HasPrefix(rest, `"`) {
+			return b.String(), rest, nil
+		}
+		b.WriteByte('"')
+		rest = rest[1:]
+	}
+}
+
+// SetElemFrom sets elem as a single element of a slice flag,
+// so it isn't split by separators, and records src as its source.
+func (f *Flag) SetElemFrom(elem string, src Source) error {
+	if _, isList := unwrapValue(f.Value).(separatorsValue); isList {
+		elem = quoteElem(elem, f.separators().elem)
+	}
+	return f.SetFrom(elem, src)
+}
+
+// SetKeyValueFrom sets value for key of a map flag, so they aren't split
+// by separators, and records src as its source.
+// A value of a map of slices is set as a single element.
+func (f *Flag) SetKeyValueFrom(key, value string, src Source) error {
+	seps := f.separators()
+	if v, casted := unwrapValue(f.Value).(*mapValue); casted && v.hasListValues() {
+		value = quoteElem(value, seps.elem)
+	}
+	return f.SetFrom(quoteElem(key, seps.kv)+seps.kv+value, src)
+}
+
+func (f *Flag) separators() *separators {
+	if f.seps == nil {
+		return &defaultSeparators
+	}
+	return f.seps
+}
+
+// quoteElem quotes elem as in CSV, if it contains sep or starts with a quote,
+// so SplitValues and SplitKeyValue return it as is. Empty sep means no split.
+func quoteElem(elem, sep string) string {
+	if sep == "" || !strings.Contains(elem, sep) && !strings.HasPrefix(elem, `"`) {
+		return elem
+	}
+	return `"` + strings.ReplaceAll(elem, `"`, `""`) + `"`
+}
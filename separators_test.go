@@ -0,0 +1,123 @@
+package sflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitValues(t *testing.T) {
+	tests := []struct {
+		raw    string
+		sep    string
+		exp    []string
+		expErr string
+	}{
+		{"a,b,c", ",", []string{"a", "b", "c"}, ""},
+		{"a,b", "", []string{"a,b"}, ""},
+		{"", ",", []string{""}, ""},
+		{"a;;b", ";;", []string{"a", "b"}, ""},
+		{`"a,b",c`, ",", []string{"a,b", "c"}, ""},
+		{`a,"b,c"`, ",", []string{"a", "b,c"}, ""},
+		{`"say ""hi""",x`, ",", []string{`say "hi"`, "x"}, ""},
+		{`"",x`, ",", []string{"", "x"}, ""},
+		{`"""a"""`, ",", []string{`"a"`}, ""},
+		{`"a`, "", []string{`"a`}, ""},
+		{`a"b,c`, ",", []string{`a"b`, "c"}, ""},
+		{`"a,b`, ",", nil, `unterminated quoted value in "\"a,b"`},
+		{`"a"b,c`, ",", nil, `unexpected characters after quoted value in "\"a\"b,c"`},
+	}
+	for _, test := range tests {
+		t.Run(test.raw, func(t *testing.T) {
+			vals, err := SplitValues(test.raw, test.sep)
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.exp, vals)
+		})
+	}
+}
+
+func TestSplitKeyValue(t *testing.T) {
+	key, value, err := SplitKeyValue("a:b:c", ":")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b:c"}, []string{key, value})
+	key, value, err = SplitKeyValue(`"a=b"=c`, "=")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a=b", "c"}, []string{key, value})
+	_, _, err = SplitKeyValue("a", "=")
+	assert.EqualError(t, err, "invalid map flag syntax, use -map=key1=val1")
+	_, _, err = SplitKeyValue(`"a"b:c`, ":")
+	assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+}
+
+func TestParseStruct_Separators(t *testing.T) {
+	cfg := &struct {
+		Patterns []string          `sep:";"`
+		Args     []string          `sep:""`
+		Hosts    map[string]string `kvsep:"="`
+		Ports    []int
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 4)
+
+	require.NoError(t, flags[0].Value.Set("a,b;^c{1,2}$"))
+	assert.Equal(t, []string{"a,b", "^c{1,2}$"}, cfg.Patterns)
+
+	require.NoError(t, flags[1].Value.Set("a,b"))
+	require.NoError(t, flags[1].Value.Set("c;d"))
+	assert.Equal(t, []string{"a,b", "c;d"}, cfg.Args)
+
+	require.NoError(t, flags[2].Value.Set("db=localhost:5432"))
+	assert.Equal(t, map[string]string{"db": "localhost:5432"}, cfg.Hosts)
+	assert.EqualError(t, flags[2].Value.Set("db:5432"), "invalid map flag syntax, use -map=key1=val1")
+
+	require.NoError(t, flags[3].Value.Set(`"80",443`))
+	assert.Equal(t, []int{80, 443}, cfg.Ports)
+	assert.EqualError(t, flags[3].Value.Set(`"80`), `unterminated quoted value in "\"80"`)
+
+	_, err = ParseStruct(&struct {
+		Name string `sep:";"`
+	}{})
+	assert.EqualError(t, err, "field Name: separators are not supported for string")
+}
+
+func TestParseStruct_SeparatorOptions(t *testing.T) {
+	cfg := &struct {
+		Names  []string
+		Labels map[string]string
+		Tags   []string `sep:","`
+	}{}
+	flags, err := ParseStruct(cfg, Separator(""), KVSeparator("="))
+	require.NoError(t, err)
+	require.Len(t, flags, 3)
+
+	require.NoError(t, flags[0].Value.Set("a,b"))
+	assert.Equal(t, []string{"a,b"}, cfg.Names)
+	require.NoError(t, flags[1].Value.Set("key=a:b"))
+	assert.Equal(t, map[string]string{"key": "a:b"}, cfg.Labels)
+	require.NoError(t, flags[2].Value.Set("a,b"))
+	assert.Equal(t, []string{"a", "b"}, cfg.Tags)
+}
+
+func TestFlag_SetElemFrom(t *testing.T) {
+	cfg := &struct {
+		Tags   []string
+		Limits map[string]int      `kvsep:"="`
+		Labels map[string][]string `sep:";"`
+	}{}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	src := Source{Kind: SourceFile}
+	require.NoError(t, flags[0].SetElemFrom("a,b", src))
+	require.NoError(t, flags[0].SetElemFrom(`"c"`, src))
+	assert.Equal(t, []string{"a,b", `"c"`}, cfg.Tags)
+	require.NoError(t, flags[1].SetKeyValueFrom("a=b", "1", src))
+	assert.Equal(t, map[string]int{"a=b": 1}, cfg.Limits)
+	require.NoError(t, flags[2].SetKeyValueFrom("x:y", "1;2", src))
+	assert.Equal(t, map[string][]string{"x:y": {"1;2"}}, cfg.Labels)
+}
@@ -0,0 +1,314 @@
+// Package source loads values for a config structure from JSON and YAML files.
+//
+// Keys of a document are flag names, that sflags.ParseStruct derives,
+// nested objects are joined by flag divider, so "http-host" flag
+// might be set by `{"http": {"host": "localhost"}}` or `{"http-host": "localhost"}`.
+// Values are set by Value.Set of flags, so validators and choices are checked too.
+//
+// Parse flags with sflags.TrackSource option, load a file to them by LoadFileTo
+// and then generate the same flags to get the precedence: default < file < env < flag.
+// The option makes command line replace lists from the file rather than append to them.
+// LoadFile, LoadJSON and LoadYAML fill a structure, that is used as is:
+// if it's parsed by sflags.ParseStruct again, `default` tags replace
+// zero values from the file, e.g. `"port": 0`, because they can't be
+// told apart from unset ones.
+//
+// Elements of slices and maps of structures are discovered from a document
+// by LoadFile, LoadJSON and LoadYAML: `{"upstream": [{"host": "a"}]}` sets
+// "upstream-0-host" flag. Use DiscoverFlags with LoadFileTo to do the same.
+package source
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/sflags"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadFile reads a JSON (.json) or YAML (.yaml, .yml) file
+// and sets values of cfg, that is a pointer to some structure, from it.
+// Use LoadFileTo to generate flags from cfg after the file is loaded.
+func LoadFile(path string, cfg interface{}, optFuncs ...sflags.OptFunc) error {
+	doc, err := readFile(path)
+	if err != nil {
+		return err
+	}
+	flags, err := sflags.ParseStruct(cfg, discover(doc, optFuncs)...)
+	if err != nil {
+		return err
+	}
+	if err := load(doc, flags, sflags.Source{Kind: sflags.SourceFile, Name: path}, optFuncs); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadFileTo reads a JSON (.json) or YAML (.yaml, .yml) file
+// and sets flags, that are parsed from some config structure, from it.
+// optFuncs should be the same as used for parsing.
+// If flags are parsed with sflags.TrackSource option, the file is recorded
+// as their source. Call it before command line is parsed
+// and generate the same flags, so zero values from the file are kept.
+func LoadFileTo(path string, flags []*sflags.Flag, optFuncs ...sflags.OptFunc) error {
+	doc, err := readFile(path)
+	if err != nil {
+		return err
+	}
+	if err := load(doc, flags, sflags.Source{Kind: sflags.SourceFile, Name: path}, optFuncs); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// DiscoverFlags reads a JSON (.json) or YAML (.yaml, .yml) file and returns
+// sflags.DiscoverFlags option with its keys, so elements of slices and maps
+// of structures in the file get flags, when they are parsed with it.
+func DiscoverFlags(path string, optFuncs ...sflags.OptFunc) (sflags.OptFunc, error) {
+	doc, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return sflags.DiscoverFlags(docFlagNames(nil, doc, optFuncs)...), nil
+}
+
+func readFile(path string) (interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		doc, err = decodeJSON(data)
+	case ".yaml", ".yml":
+		doc, err = decodeYAML(data)
+	default:
+		return nil, fmt.Errorf("unsupported config file format %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return doc, nil
+}
+
+// LoadJSON sets values of cfg, that is a pointer to some structure,
+// from JSON document.
+func LoadJSON(data []byte, cfg interface{}, optFuncs ...sflags.OptFunc) error {
+	doc, err := decodeJSON(data)
+	if err != nil {
+		return err
+	}
+	flags, err := sflags.ParseStruct(cfg, discover(doc, optFuncs)...)
+	if err != nil {
+		return err
+	}
+	return load(doc, flags, sflags.Source{Kind: sflags.SourceFile}, optFuncs)
+}
+
+// LoadYAML sets values of cfg, that is a pointer to some structure,
+// from YAML document.
+func LoadYAML(data []byte, cfg interface{}, optFuncs ...sflags.OptFunc) error {
+	doc, err := decodeYAML(data)
+	if err != nil {
+		return err
+	}
+	flags, err := sflags.ParseStruct(cfg, discover(doc, optFuncs)...)
+	if err != nil {
+		return err
+	}
+	return load(doc, flags, sflags.Source{Kind: sflags.SourceFile}, optFuncs)
+}
+
+func decodeJSON(data []byte) (interface{}, error) {
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(&doc)
+	return doc, err
+}
+
+func decodeYAML(data []byte) (interface{}, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	keepTimestamps(&node)
+	var doc interface{}
+	err := node.Decode(&doc)
+	return doc, err
+}
+
+// keepTimestamps marks timestamps, e.g. `since: 2024-01-02`, as strings,
+// so they are set as written and parsed in a layout of the field,
+// rather than decoded to time.Time.
+func keepTimestamps(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!timestamp" {
+		node.Tag = "!!str"
+	}
+	for _, child := range node.Content {
+		keepTimestamps(child)
+	}
+}
+
+func load(doc interface{}, flags []*sflags.Flag, src sflags.Source, optFuncs []sflags.OptFunc) error {
+	if doc == nil {
+		return nil
+	}
+	byName := make(map[string]*sflags.Flag, len(flags))
+	for _, flag := range flags {
+		byName[flag.Name] = flag
+	}
+	root, ok := doc.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("document must be an object, got %T", doc)
+	}
+	return loadObject(byName, nil, root, src, optFuncs)
+}
+
+func loadObject(flags map[string]*sflags.Flag, path []string, obj map[string]interface{}, src sflags.Source, optFuncs []sflags.OptFunc) error {
+	for _, key := range sortedKeys(obj) {
+		keyPath := append(path[:len(path):len(path)], key)
+		val := obj[key]
+		if flag, found := flags[sflags.FlagName(keyPath, optFuncs...)]; found {
+			if err := setFlag(flag, val, src); err != nil {
+				return fmt.Errorf("key %s: %w", strings.Join(keyPath, "."), err)
+			}
+			continue
+		}
+		nested, ok := val.(map[string]interface{})
+		if list, isList := val.([]interface{}); isList {
+			nested, ok = indexObjects(list)
+		}
+		if !ok {
+			return fmt.Errorf("unknown key %s", strings.Join(keyPath, "."))
+		}
+		if err := loadObject(flags, keyPath, nested, src, optFuncs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// discover adds keys of doc to optFuncs, so elements of slices
+// and maps of structures are discovered from it.
+func discover(doc interface{}, optFuncs []sflags.OptFunc) []sflags.OptFunc {
+	names := docFlagNames(nil, doc, optFuncs)
+	return append(optFuncs[:len(optFuncs):len(optFuncs)], sflags.DiscoverFlags(names...))
+}
+
+// docFlagNames returns flag names of all keys of val and its nested objects.
+func docFlagNames(path []string, val interface{}, optFuncs []sflags.OptFunc) []string {
+	obj, ok := val.(map[string]interface{})
+	if list, isList := val.([]interface{}); isList {
+		obj, ok = indexObjects(list)
+	}
+	if !ok {
+		return nil
+	}
+	var names []string
+	for _, key := range sortedKeys(obj) {
+		keyPath := append(path[:len(path):len(path)], key)
+		names = append(names, sflags.FlagName(keyPath, optFuncs...))
+		names = append(names, docFlagNames(keyPath, obj[key], optFuncs)...)
+	}
+	return names
+}
+
+// indexObjects converts a list of objects to an object with indexes as keys,
+// that is the way elements of slices of structures are named.
+func indexObjects(list []interface{}) (map[string]interface{}, bool) {
+	obj := make(map[string]interface{}, len(list))
+	for i, item := range list {
+		if _, ok := item.(map[string]interface{}); !ok {
+			return nil, false
+		}
+		obj[strconv.Itoa(i)] = item
+	}
+	return obj, true
+}
+
+// setFlag sets val to flag. Lists are set item by item,
+// objects are set as keys and values, that map values expect,
+// lists in objects are set as a value per item.
+// Items, keys and values are set as is, even if they contain separators.
+func setFlag(flag *sflags.Flag, val interface{}, src sflags.Source) error {
+	switch val := val.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		for _, item := range val {
+			str, err := toString(item)
+			if err != nil {
+				return err
+			}
+			if err := flag.SetElemFrom(str, src); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		for _, key := range sortedKeys(val) {
+			items, isList := val[key].([]interface{})
+			if !isList {
+				items = []interface{}{val[key]}
+			}
+			for _, item := range items {
+				str, err := toString(item)
+				if err != nil {
+					return err
+				}
+				if err := flag.SetKeyValueFrom(key, str, src); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	default:
+		return setString(flag, val, src)
+	}
+}
+
+func setString(flag *sflags.Flag, val interface{}, src sflags.Source) error {
+	str, err := toString(val)
+	if err != nil {
+		return err
+	}
+	return flag.SetFrom(str, src)
+}
+
+// toString converts a scalar value of JSON or YAML document to a string,
+// that might be parsed by Value.Set.
+func toString(val interface{}) (string, error) {
+	switch val := val.(type) {
+	case string:
+		return val, nil
+	case json.Number:
+		return val.String(), nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	case int:
+		return strconv.Itoa(val), nil
+	case uint64:
+		return strconv.FormatUint(val, 10), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v of type %T", val, val)
+	}
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,304 @@
+package source
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/sflags"
+	"github.com/urfave/sflags/gen/gflag"
+)
+
+type httpConfig struct {
+	Host    string
+	Port    int `default:"8080"`
+	Timeout time.Duration
+}
+
+type config struct {
+	HTTP    httpConfig
+	Level   string `choices:"debug,info"`
+	Tags    []string
+	Limits  map[string]int
+	Verbose bool
+}
+
+func TestLoadYAML(t *testing.T) {
+	cfg := &config{}
+	err := LoadYAML([]byte(`
+http:
+  host: localhost
+  timeout: 15s
+level: debug
+tags: [a, b]
+limits:
+  cpu: 2
+  mem: 512
+verbose: true
+`), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, &config{
+		HTTP:    httpConfig{Host: "localhost", Port: 8080, Timeout: 15 * time.Second},
+		Level:   "debug",
+		Tags:    []string{"a", "b"},
+		Limits:  map[string]int{"cpu": 2, "mem": 512},
+		Verbose: true,
+	}, cfg)
+}
+
+func TestLoadYAML_Times(t *testing.T) {
+	cfg := &struct {
+		Since   time.Time `layout:"2006-01-02"`
+		Until   time.Time
+		Days    []time.Time `layout:"2006-01-02"`
+		Comment string
+	}{}
+	err := LoadYAML([]byte(`
+since: 2024-01-02
+until: 2024-01-02T03:04:05Z
+days: [2024-01-02, 2024-01-03]
+comment: 2024-01-02
+`), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), cfg.Since)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), cfg.Until)
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	}, cfg.Days)
+	assert.Equal(t, "2024-01-02", cfg.Comment)
+}
+
+func TestLoadYAML_Maps(t *testing.T) {
+	cfg := &struct {
+		Labels   map[string][]string
+		Timeouts map[string]time.Duration
+	}{}
+	err := LoadYAML([]byte(`
+labels:
+  env: [prod, staging]
+  team: core
+timeouts:
+  "db:5432": 5s
+`), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"env": {"prod", "staging"}, "team": {"core"}}, cfg.Labels)
+	assert.Equal(t, map[string]time.Duration{"db:5432": 5 * time.Second}, cfg.Timeouts)
+}
+
+func TestLoadYAML_Separators(t *testing.T) {
+	cfg := &struct {
+		Hosts    map[string]string `kvsep:"="`
+		Tags     []string
+		Patterns []string            `sep:";"`
+		Labels   map[string][]string `kvsep:"="`
+		Args     []string            `sep:""`
+	}{}
+	err := LoadYAML([]byte(`
+hosts:
+  db: localhost:5432
+  "a=b": c
+tags: ["a,b", c, '"x"']
+patterns: ["^a{1;2}$"]
+labels:
+  "env=1": ["x,y", z]
+args: ['"q"', "r,s"]
+`), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"db": "localhost:5432", "a=b": "c"}, cfg.Hosts)
+	assert.Equal(t, []string{"a,b", "c", `"x"`}, cfg.Tags)
+	assert.Equal(t, []string{"^a{1;2}$"}, cfg.Patterns)
+	assert.Equal(t, map[string][]string{"env=1": {"x,y", "z"}}, cfg.Labels)
+	assert.Equal(t, []string{`"q"`, "r,s"}, cfg.Args)
+}
+
+func TestLoadYAML_Elems(t *testing.T) {
+	cfg := &struct {
+		Upstream []httpConfig
+		Backend  map[string]httpConfig
+	}{}
+	err := LoadYAML([]byte(`
+upstream:
+  - host: a
+  - host: b
+    port: 9090
+backend:
+  primary:
+    host: db
+`), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []httpConfig{{Host: "a", Port: 8080}, {Host: "b", Port: 9090}}, cfg.Upstream)
+	assert.Equal(t, map[string]httpConfig{"primary": {Host: "db", Port: 8080}}, cfg.Backend)
+}
+
+func TestLoadJSON_ElemKeySuffixes(t *testing.T) {
+	type backendConfig struct {
+		Port      int
+		AdminPort int
+	}
+	cfg := &struct {
+		Backend map[string]backendConfig
+	}{}
+	err := LoadJSON([]byte(`{"backend": {"primary": {"admin-port": 9}}}`), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]backendConfig{"primary": {AdminPort: 9}}, cfg.Backend)
+}
+
+func TestDiscoverFlags(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"upstream": [{"host": "a"}]}`), 0o600))
+	cfg := &struct {
+		Upstream []httpConfig
+	}{}
+	discover, err := DiscoverFlags(path)
+	require.NoError(t, err)
+	flags, err := sflags.ParseStruct(cfg, sflags.TrackSource(), discover)
+	require.NoError(t, err)
+	require.NoError(t, LoadFileTo(path, flags))
+	assert.Equal(t, []httpConfig{{Host: "a", Port: 8080}}, cfg.Upstream)
+	assert.Equal(t, sflags.Source{Kind: sflags.SourceFile, Name: path}, flags[0].Source())
+}
+
+func TestLoadJSON(t *testing.T) {
+	cfg := &config{}
+	err := LoadJSON([]byte(`{"http-host": "localhost", "http": {"port": 9090}, "tags": ["a"]}`), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, &config{
+		HTTP:   httpConfig{Host: "localhost", Port: 9090},
+		Tags:   []string{"a"},
+		Limits: map[string]int{},
+	}, cfg)
+}
+
+func TestLoadOptions(t *testing.T) {
+	cfg := &config{}
+	err := LoadJSON([]byte(`{"http": {"host": "localhost"}}`), cfg,
+		sflags.Prefix("app."), sflags.FlagDivider("."))
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", cfg.HTTP.Host)
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		doc    string
+		expErr string
+	}{
+		{"unknown key", `{"http": {"addr": "localhost"}}`, "unknown key http.addr"},
+		{"invalid value", `{"http": {"port": "port"}}`,
+			`key http.port: strconv.ParseInt: parsing "port": invalid syntax`},
+		{"choices", `{"level": "trace"}`,
+			`key level: invalid value "trace", allowed values are: debug, info`},
+		{"not object", `[1, 2]`, "document must be an object, got []interface {}"},
+		{"nested list", `{"tags": [["a"]]}`, "key tags: unsupported value [a] of type []interface {}"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := LoadJSON([]byte(test.doc), &config{})
+			assert.EqualError(t, err, test.expErr)
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "config.yml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("http:\n  host: localhost\n"), 0o600))
+	cfg := &config{}
+	require.NoError(t, LoadFile(yamlPath, cfg))
+	assert.Equal(t, "localhost", cfg.HTTP.Host)
+
+	jsonPath := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"http": {"port": "port"}}`), 0o600))
+	assert.EqualError(t, LoadFile(jsonPath, &config{}),
+		jsonPath+`: key http.port: strconv.ParseInt: parsing "port": invalid syntax`)
+
+	assert.EqualError(t, LoadFile(filepath.Join(dir, "config.toml"), &config{}),
+		"open "+filepath.Join(dir, "config.toml")+": no such file or directory")
+	tomlPath := filepath.Join(dir, "config.toml")
+	require.NoError(t, os.WriteFile(tomlPath, nil, 0o600))
+	assert.EqualError(t, LoadFile(tomlPath, &config{}), `unsupported config file format ".toml"`)
+}
+
+func TestPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+http:
+  host: file-host
+  timeout: 10s
+tags: [file]
+level: info
+`), 0o600))
+	cfg := &config{}
+	flags, err := sflags.ParseStruct(cfg, sflags.TrackSource())
+	require.NoError(t, err)
+	require.NoError(t, LoadFileTo(path, flags))
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	gflag.GenerateTo(flags, fs)
+	t.Setenv("HTTP_TIMEOUT", "20s")
+	t.Setenv("LEVEL", "debug")
+	require.NoError(t, fs.Parse([]string{"-tags", "flag", "-level", "info"}))
+	require.NoError(t, gflag.ParseEnv(flags, fs))
+
+	assert.Equal(t, "file-host", cfg.HTTP.Host)
+	assert.Equal(t, 8080, cfg.HTTP.Port)
+	assert.Equal(t, 20*time.Second, cfg.HTTP.Timeout)
+	assert.Equal(t, []string{"flag"}, cfg.Tags)
+	assert.Equal(t, "info", cfg.Level)
+}
+
+func TestLoadFileTo_ZeroValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"color": false, "port": 0, "name": ""}`), 0o600))
+	cfg := &struct {
+		Color bool   `default:"true"`
+		Port  int    `default:"8080"`
+		Name  string `default:"app"`
+	}{}
+	flags, err := sflags.ParseStruct(cfg)
+	require.NoError(t, err)
+	require.NoError(t, LoadFileTo(path, flags))
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	gflag.GenerateTo(flags, fs)
+	require.NoError(t, fs.Parse(nil))
+	assert.False(t, cfg.Color)
+	assert.Equal(t, 0, cfg.Port)
+	assert.Equal(t, "", cfg.Name)
+}
+
+func TestLoadFileTo(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("http:\n  host: file-host\n  port: 9000\ntags: [a, b]\n"), 0o600))
+
+	cfg := &config{}
+	flags, err := sflags.ParseStruct(cfg, sflags.TrackSource())
+	require.NoError(t, err)
+	require.NoError(t, LoadFileTo(path, flags))
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	gflag.GenerateTo(flags, fs)
+	t.Setenv("HTTP_PORT", "9090")
+	require.NoError(t, fs.Parse([]string{"-tags", "c"}))
+	require.NoError(t, gflag.ParseEnv(flags, fs))
+
+	assert.Equal(t, "file-host", cfg.HTTP.Host)
+	assert.Equal(t, 9090, cfg.HTTP.Port)
+	assert.Equal(t, []string{"c"}, cfg.Tags)
+
+	sources := map[string]string{}
+	for _, flag := range sflags.Changed(flags) {
+		sources[flag.Name] = flag.Source().String()
+	}
+	assert.Equal(t, map[string]string{
+		"http-host": "file " + path,
+		"http-port": "env HTTP_PORT",
+		"tags":      "cli",
+	}, sources)
+}
@@ -0,0 +1,152 @@
+package sflags
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// parseTextValue returns a Value for an addressable value of a type,
+// that implements encoding.TextUnmarshaler, or a slice of them.
+// It returns nil for other types, maps of them are parsed by parseMapValue.
+func parseTextValue(value reflect.Value) Value {
+	typ := value.Type()
+	switch {
+	case isTextUnmarshaler(typ):
+		return &textValue{value: value}
+	case typ.Kind() == reflect.Slice && isTextUnmarshaler(typ.Elem()):
+		return &textSliceValue{value: value}
+	}
+	return nil
+}
+
+func isTextUnmarshaler(typ reflect.Type) bool {
+	return typ.Kind() != reflect.Ptr && reflect.PointerTo(typ).Implements(textUnmarshalerType)
+}
+
+// unmarshalText returns a new value of typ, parsed from s.
+func unmarshalText(typ reflect.Type, s string) (reflect.Value, error) {
+	ptr := reflect.New(typ)
+	err := ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	return ptr.Elem(), err
+}
+
+// marshalText formats value by encoding.TextMarshaler if it's implemented,
+// otherwise by fmt package.
+func marshalText(value reflect.Value) string {
+	if !value.CanAddr() {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		value = ptr.Elem()
+	}
+	if marshaler, casted := value.Addr().Interface().(encoding.TextMarshaler); casted {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+func textTypeName(typ reflect.Type) string {
+	if typ.Name() == "" {
+		return "text"
+	}
+	return strings.ToLower(typ.Name())
+}
+
+// -- encoding.TextUnmarshaler Value
+
+type textValue struct {
+	value reflect.Value
+}
+
+var _ Value = (*textValue)(nil)
+var _ Getter = (*textValue)(nil)
+
+func (v *textValue) Set(s string) error {
+	parsed, err := unmarshalText(v.value.Type(), s)
+	if err != nil {
+		return err
+	}
+	v.value.Set(parsed)
+	return nil
+}
+
+func (v *textValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Interface()
+	}
+	return nil
+}
+
+func (v *textValue) String() string {
+	if v != nil && v.value.IsValid() {
+		return marshalText(v.value)
+	}
+	return ""
+}
+
+func (v *textValue) Type() string { return textTypeName(v.value.Type()) }
+
+// -- encoding.TextUnmarshaler Slice Value
+
+type textSliceValue struct {
+	value   reflect.Value
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*textSliceValue)(nil)
+var _ Value = (*textSliceValue)(nil)
+var _ Getter = (*textSliceValue)(nil)
+
+func (v *textSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *textSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	out := reflect.MakeSlice(v.value.Type(), len(ss), len(ss))
+	for i, s := range ss {
+		parsed, err := unmarshalText(v.value.Type().Elem(), s)
+		if err != nil {
+			return err
+		}
+		out.Index(i).Set(parsed)
+	}
+	if !v.changed {
+		v.value.Set(out)
+	} else {
+		v.value.Set(reflect.AppendSlice(v.value, out))
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *textSliceValue) Get() interface{} {
+	if v != nil && v.value.IsValid() {
+		return v.value.Interface()
+	}
+	return nil
+}
+
+func (v *textSliceValue) String() string {
+	if v == nil || !v.value.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, v.value.Len())
+	for i := 0; i < v.value.Len(); i++ {
+		out = append(out, marshalText(v.value.Index(i)))
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *textSliceValue) Type() string { return textTypeName(v.value.Type().Elem()) + "Slice" }
+
+func (v *textSliceValue) IsCumulative() bool {
+	return true
+}
@@ -0,0 +1,86 @@
+package sflags
+
+import (
+	"errors"
+	"log/slog"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// color implements only encoding.TextUnmarshaler, so it's printed by fmt.
+type color struct {
+	R, G, B uint8
+}
+
+func (c *color) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "red":
+		*c = color{R: 255}
+	case "blue":
+		*c = color{B: 255}
+	default:
+		return errors.New("unknown color " + string(text))
+	}
+	return nil
+}
+
+func TestParseStruct_TextUnmarshaler(t *testing.T) {
+	cfg := &struct {
+		Level   slog.Level
+		Limit   *big.Int
+		Color   color
+		Levels  []slog.Level
+		Colors  map[string]color
+		Weights map[int8]big.Int
+	}{
+		Level: slog.LevelWarn,
+		Limit: big.NewInt(10),
+	}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 6)
+
+	assert.Equal(t, "level", flags[0].Name)
+	assert.Equal(t, "WARN", flags[0].DefValue)
+	assert.Equal(t, "level", flags[0].Value.Type())
+	assert.Equal(t, "10", flags[1].DefValue)
+	assert.Equal(t, "int", flags[1].Value.Type())
+	assert.Equal(t, "{0 0 0}", flags[2].DefValue)
+	assert.Equal(t, "[]", flags[3].DefValue)
+	assert.Equal(t, "levelSlice", flags[3].Value.Type())
+	assert.Equal(t, "", flags[4].DefValue)
+	assert.Equal(t, "map[string]sflags.color", flags[4].Value.Type())
+
+	require.NoError(t, flags[0].Value.Set("debug"))
+	assert.Equal(t, slog.LevelDebug, cfg.Level)
+	assert.Equal(t, slog.LevelDebug, flags[0].Value.(Getter).Get())
+	assert.EqualError(t, flags[0].Value.Set("loud"), `slog: level string "loud": unknown name`)
+
+	require.NoError(t, flags[1].Value.Set("123456789012345678901234567890"))
+	assert.Equal(t, "123456789012345678901234567890", cfg.Limit.String())
+	assert.EqualError(t, flags[1].Value.Set("ten"), `math/big: cannot unmarshal "ten" into a *big.Int`)
+
+	require.NoError(t, flags[2].Value.Set("red"))
+	assert.Equal(t, color{R: 255}, cfg.Color)
+
+	require.NoError(t, flags[3].Value.Set("info,error"))
+	require.NoError(t, flags[3].Value.Set("warn"))
+	assert.Equal(t, []slog.Level{slog.LevelInfo, slog.LevelError, slog.LevelWarn}, cfg.Levels)
+	assert.Equal(t, "[INFO,ERROR,WARN]", flags[3].Value.String())
+	assert.EqualError(t, flags[3].Value.Set("info,loud"), `slog: level string "loud": unknown name`)
+
+	require.NoError(t, flags[4].Value.Set("fg:blue"))
+	require.NoError(t, flags[4].Value.Set("bg:red"))
+	assert.Equal(t, map[string]color{"fg": {B: 255}, "bg": {R: 255}}, cfg.Colors)
+	assert.Equal(t, "map[bg:{255 0 0} fg:{0 0 255}]", flags[4].Value.String())
+	assert.EqualError(t, flags[4].Value.Set("fg"), "invalid map flag syntax, use -map=key1:val1")
+	assert.EqualError(t, flags[4].Value.Set("fg:green"), "unknown color green")
+
+	require.NoError(t, flags[5].Value.Set("1:100"))
+	assert.Equal(t, "map[1:100]", flags[5].Value.String())
+	assert.EqualError(t, flags[5].Value.Set("300:1"), `strconv.ParseInt: parsing "300": value out of range`)
+}
@@ -0,0 +1,163 @@
+package sflags
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// layoutValue is implemented by values, that are parsed and formatted
+// with a layout from `layout` tag.
+type layoutValue interface {
+	setLayout(layout string)
+}
+
+// parseTime parses s in layout (time.RFC3339 by default),
+// as a relative time, e.g. "now" or "now-24h", or as a unix timestamp.
+// Empty string is a zero time.
+func parseTime(s, layout string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	if t, err := time.Parse(layout, s); err == nil {
+		return t, nil
+	}
+	if rel, found := strings.CutPrefix(s, "now"); found {
+		if rel == "" {
+			return time.Now(), nil
+		}
+		if d, err := time.ParseDuration(rel); err == nil && (rel[0] == '-' || rel[0] == '+') {
+			return time.Now().Add(d), nil
+		}
+	}
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(sec, 0).UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, use %q layout, now-1h or unix timestamp", s, layout)
+}
+
+func formatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return t.Format(layout)
+}
+
+// -- time.Time Value
+
+type timeValue struct {
+	value  *time.Time
+	layout string
+}
+
+var _ Value = (*timeValue)(nil)
+var _ Getter = (*timeValue)(nil)
+var _ layoutValue = (*timeValue)(nil)
+
+func newTimeValue(p *time.Time) *timeValue {
+	return &timeValue{value: p}
+}
+
+func (v *timeValue) setLayout(layout string) { v.layout = layout }
+
+func (v *timeValue) Set(s string) error {
+	parsed, err := parseTime(s, v.layout)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *timeValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *timeValue) String() string {
+	if v != nil && v.value != nil {
+		return formatTime(*v.value, v.layout)
+	}
+	return ""
+}
+
+func (v *timeValue) Type() string { return "time" }
+
+// -- time.TimeSlice Value
+
+type timeSliceValue struct {
+	value   *[]time.Time
+	layout  string
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*timeSliceValue)(nil)
+var _ Value = (*timeSliceValue)(nil)
+var _ Getter = (*timeSliceValue)(nil)
+var _ layoutValue = (*timeSliceValue)(nil)
+
+func newTimeSliceValue(slice *[]time.Time) *timeSliceValue {
+	return &timeSliceValue{
+		value: slice,
+	}
+}
+
+func (v *timeSliceValue) setLayout(layout string) { v.layout = layout }
+
+func (v *timeSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *timeSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	out := make([]time.Time, len(ss))
+	for i, s := range ss {
+		parsed, err := parseTime(s, v.layout)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *timeSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]time.Time)(nil)
+}
+
+func (v *timeSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, formatTime(elem, v.layout))
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *timeSliceValue) Type() string { return "timeSlice" }
+
+func (v *timeSliceValue) IsCumulative() bool {
+	return true
+}
@@ -0,0 +1,70 @@
+package sflags
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime_Relative(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		in  string
+		exp time.Time
+	}{
+		{"now", now},
+		{"now-24h", now.Add(-24 * time.Hour)},
+		{"now+1h30m", now.Add(90 * time.Minute)},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			parsed, err := parseTime(test.in, "")
+			require.NoError(t, err)
+			assert.WithinDuration(t, test.exp, parsed, time.Second)
+		})
+	}
+	_, err := parseTime("now24h", "")
+	assert.EqualError(t, err, `invalid time "now24h", use "2006-01-02T15:04:05Z07:00" layout, now-1h or unix timestamp`)
+}
+
+func TestParseStruct_Layout(t *testing.T) {
+	cfg := &struct {
+		Since time.Time   `layout:"2006-01-02" default:"2024-01-31"`
+		Until *time.Time  `layout:"2006-01-02 15:04"`
+		Days  []time.Time `layout:"20060102"`
+		Start time.Time
+	}{
+		Start: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 4)
+	assert.Equal(t, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), cfg.Since)
+	assert.Equal(t, "2024-01-31", flags[0].DefValue)
+	assert.Equal(t, "", flags[1].DefValue)
+	assert.Equal(t, "[]", flags[2].DefValue)
+	assert.Equal(t, "2024-01-02T03:04:05Z", flags[3].DefValue)
+
+	require.NoError(t, flags[0].Value.Set("1700000000"))
+	assert.Equal(t, "2023-11-14", flags[0].Value.String())
+	assert.EqualError(t, flags[0].Value.Set("2024-01-02T03:04:05Z"),
+		`invalid time "2024-01-02T03:04:05Z", use "2006-01-02" layout, now-1h or unix timestamp`)
+
+	require.NoError(t, flags[1].Value.Set("2024-02-03 10:20"))
+	assert.Equal(t, time.Date(2024, 2, 3, 10, 20, 0, 0, time.UTC), *cfg.Until)
+	assert.Equal(t, "2024-02-03 10:20", flags[1].Value.String())
+
+	require.NoError(t, flags[2].Value.Set("20240101,20240102"))
+	assert.Equal(t, "[20240101,20240102]", flags[2].Value.String())
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, cfg.Days)
+
+	_, err = ParseStruct(&struct {
+		Name string `layout:"2006"`
+	}{})
+	assert.EqualError(t, err, "field Name: layout is not supported for string")
+}
@@ -0,0 +1,207 @@
+package sflags
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ByteSize is a number of bytes, that might be set in a human readable form,
+// e.g. "512", "10KB" or "1.5GiB". SI units (KB, MB, ...) are powers of 1000,
+// IEC units (KiB, MiB, ...) are powers of 1024. Units are case insensitive.
+type ByteSize uint64
+
+// Byte size units.
+const (
+	Byte ByteSize = 1
+
+	KB ByteSize = 1000 * Byte
+	MB          = 1000 * KB
+	GB          = 1000 * MB
+	TB          = 1000 * GB
+	PB          = 1000 * TB
+	EB          = 1000 * PB
+
+	KiB ByteSize = 1024 * Byte
+	MiB          = 1024 * KiB
+	GiB          = 1024 * MiB
+	TiB          = 1024 * GiB
+	PiB          = 1024 * TiB
+	EiB          = 1024 * PiB
+)
+
+// byteSizeUnits are sorted from the largest to the smallest,
+// IEC unit goes before SI one of the same order.
+var byteSizeUnits = []struct {
+	name string
+	size ByteSize
+}{
+	{"EiB", EiB}, {"EB", EB},
+	{"PiB", PiB}, {"PB", PB},
+	{"TiB", TiB}, {"TB", TB},
+	{"GiB", GiB}, {"GB", GB},
+	{"MiB", MiB}, {"MB", MB},
+	{"KiB", KiB}, {"KB", KB},
+}
+
+// ParseByteSize parses a human readable byte size, e.g. "10KB" or "1.5GiB".
+// Short units, e.g. "10K", are SI units.
+func ParseByteSize(s string) (ByteSize, error) {
+	trimmed := strings.TrimSpace(s)
+	i := strings.IndexFunc(trimmed, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(trimmed)
+	}
+	number, unit := trimmed[:i], strings.TrimSpace(trimmed[i:])
+	if number == "" {
+		return 0, fmt.Errorf("invalid byte size %q", s)
+	}
+	size, found := byteSizeUnit(unit)
+	if !found {
+		return 0, fmt.Errorf("invalid byte size %q: unknown unit %q", s, unit)
+	}
+	if !strings.Contains(number, ".") {
+		n, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid byte size %q", s)
+		}
+		hi, lo := bits.Mul64(n, uint64(size))
+		if hi != 0 {
+			return 0, fmt.Errorf("invalid byte size %q: value out of range", s)
+		}
+		return ByteSize(lo), nil
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size %q", s)
+	}
+	f = math.Round(f * float64(size))
+	if f >= math.MaxUint64 {
+		return 0, fmt.Errorf("invalid byte size %q: value out of range", s)
+	}
+	return ByteSize(f), nil
+}
+
+func byteSizeUnit(unit string) (ByteSize, bool) {
+	switch strings.ToLower(unit) {
+	case "", "b":
+		return Byte, true
+	case "k":
+		return KB, true
+	case "m":
+		return MB, true
+	case "g":
+		return GB, true
+	case "t":
+		return TB, true
+	case "p":
+		return PB, true
+	case "e":
+		return EB, true
+	}
+	for _, u := range byteSizeUnits {
+		if strings.EqualFold(unit, u.name) {
+			return u.size, true
+		}
+	}
+	return 0, false
+}
+
+// String returns the size in the largest unit, that represents it
+// with at most 3 decimal places, e.g. "1.5KiB", "2KB" or "100B".
+func (v ByteSize) String() string {
+	for _, u := range byteSizeUnits {
+		if v < u.size {
+			continue
+		}
+		whole, rem := uint64(v/u.size), uint64(v%u.size)
+		hi, lo := bits.Mul64(rem, 1000)
+		fraction, fractionRem := bits.Div64(hi, lo, uint64(u.size))
+		if fractionRem != 0 {
+			continue
+		}
+		out := strconv.FormatUint(whole, 10)
+		if fraction != 0 {
+			out += strings.TrimRight(fmt.Sprintf(".%03d", fraction), "0")
+		}
+		return out + u.name
+	}
+	return strconv.FormatUint(uint64(v), 10) + "B"
+}
+
+// Rate is an amount of something per a time period,
+// that might be set as "100/s", "10MB/s" or "5/10m".
+type Rate struct {
+	Amount float64       // amount per Per, in bytes if Bytes is set
+	Per    time.Duration // time period, e.g. time.Second
+	Bytes  bool          // Amount was set with byte size units, e.g. "10MB/s"
+}
+
+// ParseRate parses a rate, e.g. "100/s", "10MB/s" or "5/10m".
+// Amount might be a number or a byte size, the period is a duration,
+// where a single unit means 1 of it, e.g. "s" is the same as "1s".
+func ParseRate(s string) (Rate, error) {
+	amount, per, found := strings.Cut(strings.TrimSpace(s), "/")
+	if !found {
+		return Rate{}, fmt.Errorf("invalid rate %q, use amount/period, e.g. 100/s", s)
+	}
+	var rate Rate
+	if f, err := strconv.ParseFloat(amount, 64); err == nil && f >= 0 {
+		rate.Amount = f
+	} else {
+		size, err := ParseByteSize(amount)
+		if err != nil {
+			return Rate{}, fmt.Errorf("invalid rate %q: %w", s, err)
+		}
+		rate.Amount = float64(size)
+		rate.Bytes = true
+	}
+	if per != "" && (per[0] < '0' || per[0] > '9') {
+		per = "1" + per
+	}
+	d, err := time.ParseDuration(per)
+	if err != nil {
+		return Rate{}, fmt.Errorf("invalid rate %q: %w", s, err)
+	}
+	if d <= 0 {
+		return Rate{}, fmt.Errorf("invalid rate %q: period must be positive", s)
+	}
+	rate.Per = d
+	return rate, nil
+}
+
+// PerSecond returns the amount per second.
+func (v Rate) PerSecond() float64 {
+	if v.Per == 0 {
+		return 0
+	}
+	return v.Amount / v.Per.Seconds()
+}
+
+// String returns the rate in the same form as ParseRate accepts, e.g. "10MB/s".
+func (v Rate) String() string {
+	if v.Per == 0 {
+		return ""
+	}
+	amount := strconv.FormatFloat(v.Amount, 'f', -1, 64)
+	if v.Bytes {
+		amount = ByteSize(v.Amount).String()
+	}
+	per := v.Per.String()
+	switch v.Per {
+	case time.Millisecond:
+		per = "ms"
+	case time.Second:
+		per = "s"
+	case time.Minute:
+		per = "m"
+	case time.Hour:
+		per = "h"
+	}
+	return amount + "/" + per
+}
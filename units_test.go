@@ -0,0 +1,97 @@
+package sflags
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		in     string
+		exp    ByteSize
+		expStr string
+		expErr string
+	}{
+		{"0", 0, "0B", ""},
+		{"512", 512, "512B", ""},
+		{"512b", 512, "512B", ""},
+		{"10KB", 10 * KB, "10KB", ""},
+		{"10k", 10 * KB, "10KB", ""},
+		{"10KiB", 10 * KiB, "10KiB", ""},
+		{"1536", 1536, "1.5KiB", ""},
+		{"1.5GiB", 3 * GiB / 2, "1.5GiB", ""},
+		{"1.25 mb", 1250 * KB, "1.25MB", ""},
+		{"1001", 1001, "1.001KB", ""},
+		{"999", 999, "999B", ""},
+		{"16EiB", 0, "", `invalid byte size "16EiB": value out of range`},
+		{"18446744073709551615", 1<<64 - 1, "18446744073709551.615KB", ""},
+		{"GB", 0, "", `invalid byte size "GB"`},
+		{"1..5GB", 0, "", `invalid byte size "1..5GB"`},
+		{"-1KB", 0, "", `invalid byte size "-1KB"`},
+		{"10XB", 0, "", `invalid byte size "10XB": unknown unit "XB"`},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			size, err := ParseByteSize(test.in)
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.exp, size)
+			assert.Equal(t, test.expStr, size.String())
+		})
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		in        string
+		exp       Rate
+		expStr    string
+		perSecond float64
+		expErr    string
+	}{
+		{"100/s", Rate{Amount: 100, Per: time.Second}, "100/s", 100, ""},
+		{"0.5/ms", Rate{Amount: 0.5, Per: time.Millisecond}, "0.5/ms", 500, ""},
+		{"10MB/s", Rate{Amount: 10e6, Per: time.Second, Bytes: true}, "10MB/s", 10e6, ""},
+		{"1KiB/2s", Rate{Amount: 1024, Per: 2 * time.Second, Bytes: true}, "1KiB/2s", 512, ""},
+		{"60/m", Rate{Amount: 60, Per: time.Minute}, "60/m", 1, ""},
+		{"100", Rate{}, "", 0, `invalid rate "100", use amount/period, e.g. 100/s`},
+		{"1x/s", Rate{}, "", 0, `invalid rate "1x/s": invalid byte size "1x": unknown unit "x"`},
+		{"1/0s", Rate{}, "", 0, `invalid rate "1/0s": period must be positive`},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			rate, err := ParseRate(test.in)
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.exp, rate)
+			assert.Equal(t, test.expStr, rate.String())
+			assert.Equal(t, test.perSecond, rate.PerSecond())
+		})
+	}
+}
+
+func TestParseStruct_Units(t *testing.T) {
+	cfg := &struct {
+		MaxSize ByteSize
+		Limit   Rate
+	}{MaxSize: 10 * MiB}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+	assert.Equal(t, "10MiB", flags[0].DefValue)
+	assert.Equal(t, "", flags[1].DefValue)
+
+	require.NoError(t, flags[0].Value.Set("1.5GB"))
+	require.NoError(t, flags[1].Value.Set("10MB/s"))
+	assert.Equal(t, 1500*MB, cfg.MaxSize)
+	assert.Equal(t, Rate{Amount: 10e6, Per: time.Second, Bytes: true}, cfg.Limit)
+}
@@ -0,0 +1,49 @@
+package sflags
+
+import "strings"
+
+// QuotedUsage returns flag.Usage with flag.Placeholder back-quoted,
+// the way flag.UnquoteUsage and similar functions of other libraries expect it.
+// If usage doesn't mention the placeholder, it's put in front of usage.
+func QuotedUsage(flag *Flag) string {
+	if flag.Placeholder == "" {
+		return flag.Usage
+	}
+	quoted := "`" + flag.Placeholder + "`"
+	if i := strings.Index(flag.Usage, flag.Placeholder); i >= 0 {
+		return flag.Usage[:i] + quoted + flag.Usage[i+len(flag.Placeholder):]
+	}
+	if flag.Usage == "" {
+		return quoted
+	}
+	return quoted + " " + flag.Usage
+}
+
+// ChoicesUsage returns usage with a hint about flag.Choices appended,
+// e.g. "output format (one of: json, yaml)", or only the hint, if usage is empty.
+// It returns usage as is, if flag has no choices.
+func ChoicesUsage(flag *Flag, usage string) string {
+	if len(flag.Choices) == 0 {
+		return usage
+	}
+	choices := "one of: " + strings.Join(flag.Choices, ", ")
+	if usage == "" {
+		return choices
+	}
+	return usage + " (" + choices + ")"
+}
+
+// unquoteUsage extracts a back-quoted name from usage and returns it
+// with the usage without quotes, e.g. "a `name` to show" -> ("name", "a name to show").
+func unquoteUsage(usage string) (name, unquoted string) {
+	start := strings.IndexByte(usage, '`')
+	if start < 0 {
+		return "", usage
+	}
+	end := strings.IndexByte(usage[start+1:], '`')
+	if end < 0 {
+		return "", usage
+	}
+	end += start + 1
+	return usage[start+1 : end], usage[:start] + usage[start+1:end] + usage[end+1:]
+}
@@ -0,0 +1,39 @@
+package sflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotedUsage(t *testing.T) {
+	assert.Equal(t, "http host", QuotedUsage(&Flag{Usage: "http host"}))
+	assert.Equal(t, "`HOST`", QuotedUsage(&Flag{Placeholder: "HOST"}))
+	assert.Equal(t, "`HOST` http host", QuotedUsage(&Flag{Usage: "http host", Placeholder: "HOST"}))
+	assert.Equal(t, "a `file` to read from file", QuotedUsage(&Flag{Usage: "a file to read from file", Placeholder: "file"}))
+}
+
+func TestChoicesUsage(t *testing.T) {
+	assert.Equal(t, "http host", ChoicesUsage(&Flag{}, "http host"))
+	assert.Equal(t, "one of: json, yaml", ChoicesUsage(&Flag{Choices: []string{"json", "yaml"}}, ""))
+	assert.Equal(t, "format (one of: json, yaml)", ChoicesUsage(&Flag{Choices: []string{"json", "yaml"}}, "format"))
+}
+
+func TestUnquoteUsage(t *testing.T) {
+	tests := []struct {
+		usage    string
+		name     string
+		unquoted string
+	}{
+		{"", "", ""},
+		{"http host", "", "http host"},
+		{"http `HOST` to bind", "HOST", "http HOST to bind"},
+		{"`file` to `read`", "file", "file to `read`"},
+		{"broken `quote", "", "broken `quote"},
+	}
+	for _, test := range tests {
+		name, unquoted := unquoteUsage(test.usage)
+		assert.Equal(t, test.name, name, test.usage)
+		assert.Equal(t, test.unquoted, unquoted, test.usage)
+	}
+}
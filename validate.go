@@ -0,0 +1,155 @@
+package sflags
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Validatable is an optional interface for config structures,
+// that check dependencies between their fields, e.g. "min < max".
+type Validatable interface {
+	Validate() error
+}
+
+// Validate calls Validate method of cfg, that is a pointer to some structure,
+// and of every nested structure, that ParseStruct walks, bottom-up:
+// nested structures are validated before their parents.
+// Call it after flags are parsed. Errors of nested structures are prefixed
+// with their field path, e.g. "HTTP.TLS: key is required", and joined.
+// Paths of elements of slices and maps of structures contain
+// their indexes or keys, e.g. "Upstream.0: host is required".
+// Subcommands (fields with `cmd` tag) aren't validated,
+// call Validate for a subcommand structure, when it's selected.
+// Validate method of an embedded structure is a part of the one of the parent,
+// that is either promoted from it or declared to override it, so it's called
+// once by the parent: call it from the overriding method. Parent's method
+// isn't called, if the embedded structure is a nil pointer.
+func Validate(cfg interface{}, optFuncs ...OptFunc) error {
+	if cfg == nil {
+		return errors.New("object cannot be nil")
+	}
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("object must be a pointer to struct")
+	}
+	return errors.Join(validateStruct(v.Elem(), nil, defOpts().apply(optFuncs...), true)...)
+}
+
+func validateStruct(value reflect.Value, path []string, opt opts, callValidate bool) []error {
+	var errs []error
+	valueType := value.Type()
+	// Validate of embedded structures is either promoted to the structure
+	// or overridden by it, reflection can't tell these cases apart.
+	hasOwnValidate := hasValidate(valueType)
+	for i := 0; i < value.NumField(); i++ {
+		field := valueType.Field(i)
+		// the same fields as parseStruct parses
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		if _, isCmd := field.Tag.Lookup(defaultCmdTag); isCmd || parseFlagTag(field, opt) == nil {
+			continue
+		}
+		fieldValue := value.Field(i)
+		embeddedValidate := field.Anonymous && hasOwnValidate && hasValidate(field.Type)
+		if fieldValue.Kind() == reflect.Ptr {
+			if fieldValue.IsNil() {
+				// a promoted method would be called with nil receiver.
+				callValidate = callValidate && !embeddedValidate
+				continue
+			}
+			fieldValue = fieldValue.Elem()
+		}
+		if isElems(fieldValue.Type()) {
+			fieldPath := append(path[:len(path):len(path)], field.Name)
+			errs = append(errs, validateElems(fieldValue, fieldPath, opt)...)
+			continue
+		}
+		if fieldValue.Kind() != reflect.Struct || !fieldValue.CanAddr() || isValue(fieldValue) {
+			continue
+		}
+		fieldPath := path
+		// anonymous structures are flatten, as their flags are.
+		if !field.Anonymous || !opt.flatten {
+			fieldPath = append(path[:len(path):len(path)], field.Name)
+		}
+		errs = append(errs, validateStruct(fieldValue, fieldPath, opt, !embeddedValidate)...)
+	}
+	// methods of unexported embedded structures can't be called by reflection,
+	// but they are promoted to the parent, if it doesn't override them.
+	if !callValidate || !value.Addr().CanInterface() {
+		return errs
+	}
+	if validator, casted := value.Addr().Interface().(Validatable); casted {
+		if err := validator.Validate(); err != nil {
+			if len(path) > 0 {
+				err = fmt.Errorf("%s: %w", strings.Join(path, "."), err)
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// validateElems validates elements of a slice or a map of structures,
+// errors are prefixed with an index or a key of an element, e.g. "Upstream.0".
+func validateElems(value reflect.Value, path []string, opt opts) []error {
+	var errs []error
+	validateElem := func(elem reflect.Value, key string) {
+		if elem.Kind() == reflect.Ptr {
+			if elem.IsNil() {
+				return
+			}
+			elem = elem.Elem()
+		}
+		if !elem.CanAddr() {
+			// map elements aren't addressable, so a copy is validated.
+			ptr := reflect.New(elem.Type())
+			ptr.Elem().Set(elem)
+			elem = ptr.Elem()
+		}
+		errs = append(errs, validateStruct(elem, append(path[:len(path):len(path)], key), opt, true)...)
+	}
+	if value.Kind() == reflect.Slice {
+		for i := 0; i < value.Len(); i++ {
+			validateElem(value.Index(i), strconv.Itoa(i))
+		}
+		return errs
+	}
+	keys := value.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	for _, key := range keys {
+		validateElem(value.MapIndex(key), key.String())
+	}
+	return errs
+}
+
+// hasValidate checks that a pointer to a structure of typ
+// (or typ itself, if it's a pointer) has Validate method.
+func hasValidate(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Ptr {
+		typ = reflect.PtrTo(typ)
+	}
+	_, found := typ.MethodByName("Validate")
+	return found
+}
+
+// isValue checks that an addressable structure is parsed as a flag value,
+// e.g. url.URL, rather than as a nested structure.
+func isValue(value reflect.Value) bool {
+	if !value.Addr().CanInterface() {
+		return false
+	}
+	valueInterface := value.Addr().Interface()
+	if parseGenerated(valueInterface) != nil {
+		return true
+	}
+	if _, casted := valueInterface.(Value); casted {
+		return true
+	}
+	return isTextUnmarshaler(value.Type())
+}
@@ -0,0 +1,161 @@
+package sflags
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tlsValidateConfig struct {
+	Enabled bool
+	Cert    string
+}
+
+func (c tlsValidateConfig) Validate() error {
+	if c.Enabled && c.Cert == "" {
+		return errors.New("cert is required")
+	}
+	return nil
+}
+
+type httpValidateConfig struct {
+	Port int
+	TLS  *tlsValidateConfig
+	URL  url.URL
+}
+
+type RangeValidateConfig struct {
+	Min, Max int
+}
+
+func (c *RangeValidateConfig) Validate() error {
+	if c.Min > c.Max {
+		return errors.New("min must be less than max")
+	}
+	return nil
+}
+
+type validateConfig struct {
+	RangeValidateConfig
+	HTTP    httpValidateConfig
+	Skipped tlsValidateConfig `flag:"-"`
+	Serve   tlsValidateConfig `cmd:"serve"`
+}
+
+func (c *validateConfig) Validate() error {
+	// the method overrides the one of embedded RangeValidateConfig.
+	err := c.RangeValidateConfig.Validate()
+	if c.HTTP.TLS != nil && c.HTTP.TLS.Enabled && c.HTTP.Port == 80 {
+		err = errors.Join(err, errors.New("tls can't be enabled on port 80"))
+	}
+	return err
+}
+
+func TestValidate(t *testing.T) {
+	cfg := &validateConfig{
+		RangeValidateConfig: RangeValidateConfig{Min: 1, Max: 10},
+		HTTP:                httpValidateConfig{Port: 443, TLS: &tlsValidateConfig{}},
+		Skipped:             tlsValidateConfig{Enabled: true},
+		Serve:               tlsValidateConfig{Enabled: true},
+	}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	assert.NoError(t, Validate(cfg))
+
+	set := func(name, val string) {
+		for _, flag := range flags {
+			if flag.Name == name {
+				require.NoError(t, flag.Value.Set(val))
+				return
+			}
+		}
+		t.Fatalf("flag %s not found", name)
+	}
+	set("min", "20")
+	set("http-port", "80")
+	set("http-tls-enabled", "true")
+	assert.EqualError(t, Validate(cfg), "HTTP.TLS: cert is required\n"+
+		"min must be less than max\n"+
+		"tls can't be enabled on port 80")
+
+	set("http-tls-cert", "cert.pem")
+	err = Validate(cfg)
+	assert.EqualError(t, err, "min must be less than max\ntls can't be enabled on port 80")
+
+	assert.EqualError(t, Validate(cfg.HTTP), "object must be a pointer to struct")
+	assert.EqualError(t, Validate(nil), "object cannot be nil")
+}
+
+func TestValidate_Promoted(t *testing.T) {
+	embedded := &struct {
+		RangeValidateConfig
+	}{RangeValidateConfig{Min: 10, Max: 1}}
+	assert.EqualError(t, Validate(embedded), "min must be less than max")
+
+	embeddedPtr := &struct {
+		*RangeValidateConfig
+		Port int
+	}{}
+	assert.NoError(t, Validate(embeddedPtr))
+	embeddedPtr.RangeValidateConfig = &RangeValidateConfig{Min: 10, Max: 1}
+	assert.EqualError(t, Validate(embeddedPtr), "min must be less than max")
+
+	unexported := &struct {
+		wrappedValidateConfig
+	}{wrappedValidateConfig{err: errors.New("cert is required")}}
+	assert.EqualError(t, Validate(unexported), "cert is required")
+
+	// an overriding method doesn't call the embedded one.
+	assert.NoError(t, Validate(&overrideValidateConfig{RangeValidateConfig{Min: 10, Max: 1}}))
+}
+
+type overrideValidateConfig struct {
+	RangeValidateConfig
+}
+
+func (c *overrideValidateConfig) Validate() error { return nil }
+
+func TestValidate_Elems(t *testing.T) {
+	cfg := &struct {
+		Upstream []tlsValidateConfig
+		Mirrors  []*tlsValidateConfig
+		Backend  map[string]tlsValidateConfig
+		Ranges   map[string]*RangeValidateConfig
+	}{
+		Upstream: []tlsValidateConfig{{}, {Enabled: true}},
+		Mirrors:  []*tlsValidateConfig{nil, {Enabled: true}},
+		Backend: map[string]tlsValidateConfig{
+			"secondary": {Enabled: true},
+			"primary":   {Enabled: true},
+		},
+		Ranges: map[string]*RangeValidateConfig{"port": {Min: 10, Max: 1}},
+	}
+	assert.EqualError(t, Validate(cfg), "Upstream.1: cert is required\n"+
+		"Mirrors.1: cert is required\n"+
+		"Backend.primary: cert is required\n"+
+		"Backend.secondary: cert is required\n"+
+		"Ranges.port: min must be less than max")
+}
+
+func TestValidate_Unwrap(t *testing.T) {
+	errCert := errors.New("cert is required")
+	cfg := &struct {
+		TLS struct {
+			Inner wrappedValidateConfig
+		}
+	}{}
+	cfg.TLS.Inner.err = errCert
+	err := Validate(cfg)
+	assert.EqualError(t, err, "TLS.Inner: cert is required")
+	assert.ErrorIs(t, err, errCert)
+}
+
+type wrappedValidateConfig struct {
+	Value string
+	err   error
+}
+
+func (c *wrappedValidateConfig) Validate() error { return c.err }
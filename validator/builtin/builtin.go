@@ -0,0 +1,370 @@
+// Package builtin adds a dependency-free validator with common rules.
+//
+// Rules are set by `validate` tag and separated by comma,
+// arguments follow an equal sign, e.g. `validate:"min=1,max=10"`:
+//
+//   - min=N, max=N: a number isn't less (greater) than N,
+//     a string isn't shorter (longer) than N characters
+//   - len=N: a number is equal to N, a string is exactly N characters long
+//   - oneof=a b c: a value is one of space separated values
+//   - regex=expr: a value matches the regular expression,
+//     it must be the last rule, because expr might contain commas
+//   - nonempty: a value isn't empty
+//   - port: a value is a port number from 1 to 65535
+//   - hostname: a value is a hostname as defined by RFC 1123
+//   - cidr: a value is an IP network in CIDR notation
+//   - url: a value is an absolute URL
+//
+// Numbers are compared for numeric fields, including time.Duration ("min=1s")
+// and sflags.ByteSize ("max=10MB"). Rules are applied to each element
+// of slices and arrays and to each value of maps (or its elements for maps
+// of slices), split by separators of the flag (`sep` and `kvsep` tags,
+// sflags.Separator and sflags.KVSeparator options).
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/urfave/sflags"
+)
+
+const (
+	validateTag = "validate"
+	sepTag      = "sep"
+	kvSepTag    = "kvsep"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	byteSizeType = reflect.TypeOf(sflags.ByteSize(0))
+	hexBytesType = reflect.TypeOf(sflags.HexBytes(nil))
+
+	hostnameRegexp = regexp.MustCompile(`^([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])(\.([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]{0,61}[a-zA-Z0-9]))*$`)
+)
+
+type rule struct {
+	name string
+	arg  string
+}
+
+// parseTag parses `validate:"min=1,regex=^a,b$"` tag into a list of rules.
+func parseTag(tag string) []rule {
+	var rules []rule
+	for tag != "" {
+		var part string
+		if strings.HasPrefix(tag, "regex=") {
+			part, tag = tag, ""
+		} else {
+			part, tag, _ = strings.Cut(tag, ",")
+		}
+		name, arg, _ := strings.Cut(part, "=")
+		rules = append(rules, rule{name: strings.TrimSpace(name), arg: arg})
+	}
+	return rules
+}
+
+// elemType returns the type of values, that val consists of,
+// and a function to split val into them, if field is a slice or a map.
+func elemType(field reflect.StructField) (reflect.Type, func(val string) []string) {
+	typ := field.Type
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch {
+	case typ == hexBytesType:
+		return typ, nil
+	case isList(typ):
+		return typ.Elem(), func(val string) []string {
+			return splitList(field, val)
+		}
+	case typ.Kind() == reflect.Map:
+		kvSep := field.Tag.Get(kvSepTag)
+		if kvSep == "" {
+			kvSep = ":"
+		}
+		split := func(val string) []string {
+			_, value, err := sflags.SplitKeyValue(val, kvSep)
+			if err != nil {
+				// map value reports syntax error itself
+				return nil
+			}
+			return []string{value}
+		}
+		if !isList(typ.Elem()) {
+			return typ.Elem(), split
+		}
+		// values of maps of slices are split to elements too, e.g. "a:1,2"
+		return typ.Elem().Elem(), func(val string) []string {
+			var vals []string
+			for _, value := range split(val) {
+				vals = append(vals, splitList(field, value)...)
+			}
+			return vals
+		}
+	}
+	return typ, nil
+}
+
+// isList checks that typ is a slice or an array, which elements are validated.
+func isList(typ reflect.Type) bool {
+	return typ != hexBytesType && (typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array)
+}
+
+// splitList splits val of a slice or an array field to elements.
+func splitList(field reflect.StructField, val string) []string {
+	sep, found := field.Tag.Lookup(sepTag)
+	if !found {
+		sep = ","
+	}
+	// slice value reports syntax error itself
+	vals, _ := sflags.SplitValues(val, sep)
+	return vals
+}
+
+// number is a parsed numeric value, only one field is used depending on a type.
+type number struct {
+	i int64
+	u uint64
+	f float64
+}
+
+func (n number) cmp(other number) int {
+	switch {
+	case n.i < other.i || n.u < other.u || n.f < other.f:
+		return -1
+	case n.i > other.i || n.u > other.u || n.f > other.f:
+		return 1
+	}
+	return 0
+}
+
+func isNumeric(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func parseNumber(typ reflect.Type, s string) (number, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case typ == durationType:
+		d, err := time.ParseDuration(s)
+		return number{i: int64(d)}, err
+	case typ == byteSizeType:
+		size, err := sflags.ParseByteSize(s)
+		return number{u: uint64(size)}, err
+	}
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 0, 64)
+		return number{i: i}, err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 0, 64)
+		return number{u: u}, err
+	default:
+		f, err := strconv.ParseFloat(s, 64)
+		return number{f: f}, err
+	}
+}
+
+// compare compares val with arg of rule: numbers for numeric types
+// and length of val for others. skip is true if val isn't a number,
+// so the flag value reports it itself.
+func compare(typ reflect.Type, r rule, val string) (cmp int, skip bool, err error) {
+	if isNumeric(typ) {
+		limit, err := parseNumber(typ, r.arg)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid argument %q of rule %s: %w", r.arg, r.name, err)
+		}
+		n, err := parseNumber(typ, val)
+		if err != nil {
+			return 0, true, nil
+		}
+		return n.cmp(limit), false, nil
+	}
+	limit, err := strconv.Atoi(r.arg)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid argument %q of rule %s: %w", r.arg, r.name, err)
+	}
+	length := utf8.RuneCountInString(val)
+	switch {
+	case length < limit:
+		return -1, false, nil
+	case length > limit:
+		return 1, false, nil
+	}
+	return 0, false, nil
+}
+
+func validate(typ reflect.Type, r rule, val string) error {
+	switch r.name {
+	case "min", "max", "len":
+		cmp, skip, err := compare(typ, r, val)
+		if err != nil || skip {
+			return err
+		}
+		unit := ""
+		if !isNumeric(typ) {
+			unit = " characters"
+		}
+		switch {
+		case r.name == "min" && cmp < 0:
+			return fmt.Errorf("value %q must be at least %s%s", val, r.arg, unit)
+		case r.name == "max" && cmp > 0:
+			return fmt.Errorf("value %q must be at most %s%s", val, r.arg, unit)
+		case r.name == "len" && cmp != 0:
+			return fmt.Errorf("value %q must be exactly %s%s", val, r.arg, unit)
+		}
+	case "oneof":
+		allowed := strings.Fields(r.arg)
+		for _, a := range allowed {
+			if a == val {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %q, allowed values are: %s", val, strings.Join(allowed, ", "))
+	case "regex":
+		re, err := regexp.Compile(r.arg)
+		if err != nil {
+			return fmt.Errorf("invalid argument %q of rule %s: %w", r.arg, r.name, err)
+		}
+		if !re.MatchString(val) {
+			return fmt.Errorf("value %q must match %s", val, r.arg)
+		}
+	case "nonempty":
+		if strings.TrimSpace(val) == "" {
+			return errors.New("value must not be empty")
+		}
+	case "port":
+		port, err := strconv.ParseUint(strings.TrimSpace(val), 10, 16)
+		if err != nil || port == 0 {
+			return fmt.Errorf("value %q is not a valid port", val)
+		}
+	case "hostname":
+		if len(val) > 253 || !hostnameRegexp.MatchString(val) {
+			return fmt.Errorf("value %q is not a valid hostname", val)
+		}
+	case "cidr":
+		if _, _, err := net.ParseCIDR(val); err != nil {
+			return fmt.Errorf("value %q is not a valid CIDR", val)
+		}
+	case "url":
+		u, err := url.Parse(val)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("value %q is not a valid URL", val)
+		}
+	default:
+		return fmt.Errorf("unknown validation rule %q", r.name)
+	}
+	return nil
+}
+
+// checkRule checks that r is a known rule and its argument is valid for typ.
+func checkRule(typ reflect.Type, r rule) error {
+	switch r.name {
+	case "min", "max", "len":
+		_, _, err := compare(typ, r, r.arg)
+		return err
+	case "regex":
+		if _, err := regexp.Compile(r.arg); err != nil {
+			return fmt.Errorf("invalid argument %q of rule %s: %w", r.arg, r.name, err)
+		}
+	case "oneof", "nonempty", "port", "hostname", "cidr", "url":
+	default:
+		return fmt.Errorf("unknown validation rule %q", r.name)
+	}
+	return nil
+}
+
+// CheckTags checks `validate` tags of cfg, that is a pointer to some structure,
+// and of its nested structures (including elements of slices and maps),
+// so unknown rules, e.g. `validate:"mn=1"`, and invalid arguments are reported
+// before any value is set, rather than when a flag is set.
+// Errors are prefixed with field path, e.g. "HTTP.Port: ...", and joined.
+func CheckTags(cfg interface{}) error {
+	typ := reflect.TypeOf(cfg)
+	if typ == nil || typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		return errors.New("object must be a pointer to struct")
+	}
+	return errors.Join(checkStructTags(typ.Elem(), nil, map[reflect.Type]bool{})...)
+}
+
+func checkStructTags(typ reflect.Type, path []string, parents map[reflect.Type]bool) []error {
+	// parents are structures on the path, so recursive ones are checked once
+	if parents[typ] {
+		return nil
+	}
+	parents[typ] = true
+	defer delete(parents, typ)
+	var errs []error
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		fieldPath := append(path[:len(path):len(path)], field.Name)
+		if tag := field.Tag.Get(validateTag); tag != "" {
+			elem, _ := elemType(field)
+			for _, r := range parseTag(tag) {
+				if err := checkRule(elem, r); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", strings.Join(fieldPath, "."), err))
+				}
+			}
+		}
+		if nested := structType(field.Type); nested != nil {
+			errs = append(errs, checkStructTags(nested, fieldPath, parents)...)
+		}
+	}
+	return errs
+}
+
+// structType returns a structure type of typ or of its elements,
+// if typ is a pointer, a slice, an array or a map, or nil.
+func structType(typ reflect.Type) reflect.Type {
+	for {
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			typ = typ.Elem()
+		case reflect.Struct:
+			return typ
+		default:
+			return nil
+		}
+	}
+}
+
+// New returns ValidateFunc, that checks values by rules from `validate` tag.
+func New() sflags.ValidateFunc {
+	return func(val string, field reflect.StructField, obj interface{}) error {
+		tag := field.Tag.Get(validateTag)
+		if tag == "" {
+			return nil
+		}
+		typ, split := elemType(field)
+		vals := []string{val}
+		if split != nil {
+			vals = split(val)
+		}
+		for _, r := range parseTag(tag) {
+			for _, v := range vals {
+				if err := validate(typ, r, v); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,193 @@
+package builtin
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/sflags"
+	"github.com/urfave/sflags/gen/gflag"
+)
+
+func ExampleNew() {
+	type config struct {
+		Host    string        `validate:"hostname"`
+		Port    int           `validate:"port"`
+		Workers int           `validate:"min=1,max=16"`
+		Timeout time.Duration `validate:"min=1s"`
+	}
+	cfg := &config{
+		Host:    "localhost",
+		Port:    6000,
+		Workers: 4,
+		Timeout: time.Second,
+	}
+	// Use gflags.ParseToDef if you want default `flag.CommandLine`
+	fs, err := gflag.Parse(cfg, sflags.Validator(New()))
+	if err != nil {
+		log.Fatalf("err: %v", err)
+	}
+	fs.Init("text", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	for _, args := range [][]string{
+		{"-host", "wrong domain"},
+		{"-port", "800000"},
+		{"-workers", "32"},
+		{"-timeout", "10ms"},
+	} {
+		if err = fs.Parse(args); err != nil {
+			fmt.Printf("err: %v\n", err)
+		}
+	}
+	// Output:
+	// err: invalid value "wrong domain" for flag -host: value "wrong domain" is not a valid hostname
+	// err: invalid value "800000" for flag -port: value "800000" is not a valid port
+	// err: invalid value "32" for flag -workers: value "32" must be at most 16
+	// err: invalid value "10ms" for flag -timeout: value "10ms" must be at least 1s
+}
+
+func TestNew(t *testing.T) {
+	type config struct {
+		Name     string            `validate:"nonempty,min=2,max=5"`
+		Code     string            `validate:"len=3,regex=^[a-z,]+$"`
+		Level    string            `validate:"oneof=debug info"`
+		Ratio    float64           `validate:"min=0.5,max=1"`
+		Count    uint              `validate:"len=3"`
+		Size     sflags.ByteSize   `validate:"max=1KiB"`
+		Network  string            `validate:"cidr"`
+		Endpoint string            `validate:"url"`
+		Ports    []int             `validate:"port"`
+		Labels   map[string]string `validate:"max=3"`
+		Hosts    []string          `validate:"hostname" sep:";"`
+		Limits   map[string]int    `validate:"max=10" kvsep:"="`
+		Quotas   map[string][]int  `validate:"min=1"`
+		Hex      sflags.HexBytes   `validate:"len=4"`
+		Plain    string
+		Unknown  string `validate:"even"`
+		BadArg   int    `validate:"min=one"`
+		BadRegex string `validate:"regex=["`
+	}
+	tests := []struct {
+		field  string
+		val    string
+		expErr string
+	}{
+		{"Name", "abc", ""},
+		{"Name", "  ", "value must not be empty"},
+		{"Name", "a", `value "a" must be at least 2 characters`},
+		{"Name", "абвгде", `value "абвгде" must be at most 5 characters`},
+		{"Code", "a,b", ""},
+		{"Code", "abcd", `value "abcd" must be exactly 3 characters`},
+		{"Code", "AB1", `value "AB1" must match ^[a-z,]+$`},
+		{"Level", "info", ""},
+		{"Level", "trace", `invalid value "trace", allowed values are: debug, info`},
+		{"Ratio", "0.75", ""},
+		{"Ratio", "0.1", `value "0.1" must be at least 0.5`},
+		{"Ratio", "1.5", `value "1.5" must be at most 1`},
+		{"Ratio", "x", ""},
+		{"Count", "3", ""},
+		{"Count", "0x3", ""},
+		{"Count", "4", `value "4" must be exactly 3`},
+		{"Size", "1KB", ""},
+		{"Size", "2KB", `value "2KB" must be at most 1KiB`},
+		{"Network", "10.0.0.0/8", ""},
+		{"Network", "10.0.0.0", `value "10.0.0.0" is not a valid CIDR`},
+		{"Endpoint", "https://example.com/path", ""},
+		{"Endpoint", "/path", `value "/path" is not a valid URL`},
+		{"Ports", "80,443", ""},
+		{"Ports", "80,0", `value "0" is not a valid port`},
+		{"Labels", "key:abc", ""},
+		{"Labels", "key:abcd", `value "abcd" must be at most 3 characters`},
+		{"Labels", "key", ""},
+		{"Labels", `"a:b":abc`, ""},
+		{"Labels", `"a:b":abcd`, `value "abcd" must be at most 3 characters`},
+		{"Hosts", "a.com;b.com", ""},
+		{"Hosts", "a.com;b,com", `value "b,com" is not a valid hostname`},
+		{"Limits", "a=10", ""},
+		{"Limits", "a=11", `value "11" must be at most 10`},
+		{"Quotas", "a:1,5", ""},
+		{"Quotas", "a:0,5", `value "0" must be at least 1`},
+		{"Hex", "abcd", ""},
+		{"Hex", "ab", `value "ab" must be exactly 4 characters`},
+		{"Plain", "", ""},
+		{"Unknown", "1", `unknown validation rule "even"`},
+		{"BadArg", "1", `invalid argument "one" of rule min: strconv.ParseInt: parsing "one": invalid syntax`},
+		{"BadRegex", "1", "invalid argument \"[\" of rule regex: error parsing regexp: missing closing ]: `[`"},
+	}
+	validate := New()
+	cfg := &config{}
+	for _, test := range tests {
+		t.Run(test.field+"="+test.val, func(t *testing.T) {
+			field, found := reflect.TypeOf(cfg).Elem().FieldByName(test.field)
+			assert.True(t, found)
+			err := validate(test.val, field, cfg)
+			if test.expErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expErr)
+			}
+		})
+	}
+}
+
+func TestHostname(t *testing.T) {
+	field := reflect.StructField{Name: "Host", Type: reflect.TypeOf(""), Tag: `validate:"hostname"`}
+	validate := New()
+	for _, host := range []string{"localhost", "example.com", "a-b.example.com", "127.0.0.1"} {
+		assert.NoError(t, validate(host, field, nil), host)
+	}
+	for _, host := range []string{"", "-a.com", "a_b.com", "a..b", "example.com."} {
+		assert.Error(t, validate(host, field, nil), host)
+	}
+}
+
+func TestNew_Separators(t *testing.T) {
+	cfg := &struct {
+		Ports  []int          `validate:"min=1"`
+		Limits map[string]int `validate:"min=1"`
+		Hosts  []string       `validate:"hostname" sep:","`
+	}{}
+	flags, err := sflags.ParseStruct(cfg, sflags.Validator(New()), sflags.Separator(";"), sflags.KVSeparator("="))
+	require.NoError(t, err)
+	assert.EqualError(t, flags[0].Value.Set("0;5"), `value "0" must be at least 1`)
+	assert.NoError(t, flags[0].Value.Set("1;5"))
+	assert.EqualError(t, flags[1].Value.Set("a=0"), `value "0" must be at least 1`)
+	assert.NoError(t, flags[1].Value.Set("a=1"))
+	assert.NoError(t, flags[2].Value.Set("a.com,b.com"))
+}
+
+func TestCheckTags(t *testing.T) {
+	type upstream struct {
+		Host string `validate:"hostname"`
+		Port int    `validate:"mn=1"`
+	}
+	type config struct {
+		Workers  int           `validate:"min=1,max=16"`
+		Timeout  time.Duration `validate:"min=1x"`
+		Name     string        `validate:"max=ten"`
+		Code     string        `validate:"regex=^[A-Z+$"`
+		Ports    []int         `validate:"min=1"`
+		Upstream []upstream
+		Backup   *upstream
+		Next     *config
+	}
+	assert.NoError(t, CheckTags(&struct {
+		Workers int              `validate:"min=1,max=16"`
+		Ports   map[string][]int `validate:"min=1"`
+	}{}))
+	assert.EqualError(t, CheckTags(&config{}),
+		`Timeout: invalid argument "1x" of rule min: time: unknown unit "x" in duration "1x"`+"\n"+
+			`Name: invalid argument "ten" of rule max: strconv.Atoi: parsing "ten": invalid syntax`+"\n"+
+			"Code: invalid argument \"^[A-Z+$\" of rule regex: error parsing regexp: missing closing ]: `[A-Z+$`\n"+
+			`Upstream.Port: unknown validation rule "mn"`+"\n"+
+			`Backup.Port: unknown validation rule "mn"`)
+	assert.EqualError(t, CheckTags(config{}), "object must be a pointer to struct")
+	assert.EqualError(t, CheckTags(nil), "object must be a pointer to struct")
+}
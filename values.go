@@ -5,6 +5,7 @@ package sflags
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -34,7 +35,8 @@ type Getter interface {
 }
 
 // BoolFlag is an optional interface to indicate boolean flags
-// that don't accept v value, and implicitly have v --no-<x> negation counterpart.
+// that don't accept v value. Boolean fields might have v --no-<x> negation
+// counterpart, see Negatable option and Flag.Negation.
 type BoolFlag interface {
 	Value
 	IsBoolFlag() bool
@@ -68,6 +70,28 @@ func (v *validateValue) IsCumulative() bool {
 	return false
 }
 
+// Get returns the value of the wrapped one, so wrappers still implement
+// Getter, e.g. urfave/cli v3 expects it from values set by environment.
+func (v *validateValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+	return nil
+}
+
+func (v *validateValue) unwrapValue() Value { return v.Value }
+
+// unwrapValue returns the original value, wrapped by the parser.
+func unwrapValue(value Value) Value {
+	for {
+		unwrapper, casted := value.(interface{ unwrapValue() Value })
+		if !casted {
+			return value
+		}
+		value = unwrapper.unwrapValue()
+	}
+}
+
 func (v *validateValue) String() string {
 	if v == nil || v.Value == nil {
 		return ""
@@ -85,6 +109,43 @@ func (v *validateValue) Set(val string) error {
 	return v.Value.Set(val)
 }
 
+// onSetValue calls onSet after every successful Set.
+type onSetValue struct {
+	Value
+	onSet func()
+}
+
+func (v *onSetValue) IsBoolFlag() bool {
+	if boolFlag, casted := v.Value.(BoolFlag); casted {
+		return boolFlag.IsBoolFlag()
+	}
+	return false
+}
+
+func (v *onSetValue) IsCumulative() bool {
+	if cumulativeFlag, casted := v.Value.(RepeatableFlag); casted {
+		return cumulativeFlag.IsCumulative()
+	}
+	return false
+}
+
+func (v *onSetValue) Get() interface{} {
+	if getter, casted := v.Value.(Getter); casted {
+		return getter.Get()
+	}
+	return nil
+}
+
+func (v *onSetValue) unwrapValue() Value { return v.Value }
+
+func (v *onSetValue) Set(val string) error {
+	if err := v.Value.Set(val); err != nil {
+		return err
+	}
+	v.onSet()
+	return nil
+}
+
 // HexBytes might be used if you want to parse slice of bytes as hex string.
 // Original `[]byte` or `[]uint8` parsed as a list of `uint8`.
 type HexBytes []byte
@@ -165,3 +226,65 @@ func parseIPNet(s string) (net.IPNet, error) {
 	}
 	return *ipNet, nil
 }
+
+// formatValid returns an empty string for an invalid value,
+// e.g. zero netip.Addr, instead of "invalid IP".
+func formatValid(v interface {
+	IsValid() bool
+	String() string
+}) string {
+	if !v.IsValid() {
+		return ""
+	}
+	return v.String()
+}
+
+// arrayOffset returns the index, that n parsed elements are copied from
+// to an array of size elements, when changed elements are set already.
+// All elements are set at once or one per repeated flag.
+func arrayOffset(size, changed, n int) (int, error) {
+	switch {
+	case changed == 0 && n == size:
+		return 0, nil
+	case changed+n > size:
+		return 0, fmt.Errorf("too many elements, expected %d", size)
+	case n != 1:
+		return 0, fmt.Errorf("expected %d elements or one per flag, got %d", size, n)
+	}
+	return changed, nil
+}
+
+// arrayValue is implemented by values of fixed-size arrays.
+type arrayValue interface {
+	// arrayElems returns number of elements set by repeated flags
+	// and size of the array.
+	arrayElems() (changed, size int)
+}
+
+// CheckArrays returns an error listing array flags, that were set
+// by fewer repeated flags than the array has elements, e.g. "--coords=1"
+// for [2]float64. Call it after flags are parsed.
+func CheckArrays(flags []*Flag) error {
+	var partial []string
+	for _, flag := range flags {
+		array, casted := unwrapValue(flag.Value).(arrayValue)
+		if !casted {
+			continue
+		}
+		if changed, size := array.arrayElems(); changed > 0 && changed < size {
+			partial = append(partial, fmt.Sprintf("%q (%d of %d elements)", flag.Name, changed, size))
+		}
+	}
+	if len(partial) > 0 {
+		return fmt.Errorf("array flag(s) %s set partially", strings.Join(partial, ", "))
+	}
+	return nil
+}
+
+func parseURL(s string) (url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return url.URL{}, err
+	}
+	return *u, nil
+}
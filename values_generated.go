@@ -4,9 +4,10 @@ package sflags
 
 import (
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"net"
+	"net/netip"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -69,6 +70,26 @@ func parseGenerated(value interface{}) Value {
 		return newTCPAddrValue(v)
 	case *net.IPNet:
 		return newIPNetValue(v)
+	case *url.URL:
+		return newURLValue(v)
+	case *ByteSize:
+		return newByteSizeValue(v)
+	case *Rate:
+		return newRateValue(v)
+	case *ExistingFile:
+		return newExistingFileValue(v)
+	case *ExistingDir:
+		return newExistingDirValue(v)
+	case *File:
+		return newFileValue(v)
+	case *time.Time:
+		return newTimeValue(v)
+	case *netip.Addr:
+		return newAddrValue(v)
+	case *netip.Prefix:
+		return newPrefixValue(v)
+	case *netip.AddrPort:
+		return newAddrPortValue(v)
 	case *[]string:
 		return newStringSliceValue(v)
 	case *[]bool:
@@ -109,6 +130,26 @@ func parseGenerated(value interface{}) Value {
 		return newTCPAddrSliceValue(v)
 	case *[]net.IPNet:
 		return newIPNetSliceValue(v)
+	case *[]url.URL:
+		return newURLSliceValue(v)
+	case *[]ByteSize:
+		return newByteSizeSliceValue(v)
+	case *[]Rate:
+		return newRateSliceValue(v)
+	case *[]ExistingFile:
+		return newExistingFileSliceValue(v)
+	case *[]ExistingDir:
+		return newExistingDirSliceValue(v)
+	case *[]File:
+		return newFileSliceValue(v)
+	case *[]time.Time:
+		return newTimeSliceValue(v)
+	case *[]netip.Addr:
+		return newAddrSliceValue(v)
+	case *[]netip.Prefix:
+		return newPrefixSliceValue(v)
+	case *[]netip.AddrPort:
+		return newAddrPortSliceValue(v)
 	default:
 		return nil
 	}
@@ -543,6 +584,208 @@ func parseGeneratedMap(value interface{}) Value {
 		return newUint32IPNetMapValue(v)
 	case *map[uint64]net.IPNet:
 		return newUint64IPNetMapValue(v)
+	case *map[string]url.URL:
+		return newStringURLMapValue(v)
+	case *map[int]url.URL:
+		return newIntURLMapValue(v)
+	case *map[int8]url.URL:
+		return newInt8URLMapValue(v)
+	case *map[int16]url.URL:
+		return newInt16URLMapValue(v)
+	case *map[int32]url.URL:
+		return newInt32URLMapValue(v)
+	case *map[int64]url.URL:
+		return newInt64URLMapValue(v)
+	case *map[uint]url.URL:
+		return newUintURLMapValue(v)
+	case *map[uint8]url.URL:
+		return newUint8URLMapValue(v)
+	case *map[uint16]url.URL:
+		return newUint16URLMapValue(v)
+	case *map[uint32]url.URL:
+		return newUint32URLMapValue(v)
+	case *map[uint64]url.URL:
+		return newUint64URLMapValue(v)
+	case *map[string]ByteSize:
+		return newStringByteSizeMapValue(v)
+	case *map[int]ByteSize:
+		return newIntByteSizeMapValue(v)
+	case *map[int8]ByteSize:
+		return newInt8ByteSizeMapValue(v)
+	case *map[int16]ByteSize:
+		return newInt16ByteSizeMapValue(v)
+	case *map[int32]ByteSize:
+		return newInt32ByteSizeMapValue(v)
+	case *map[int64]ByteSize:
+		return newInt64ByteSizeMapValue(v)
+	case *map[uint]ByteSize:
+		return newUintByteSizeMapValue(v)
+	case *map[uint8]ByteSize:
+		return newUint8ByteSizeMapValue(v)
+	case *map[uint16]ByteSize:
+		return newUint16ByteSizeMapValue(v)
+	case *map[uint32]ByteSize:
+		return newUint32ByteSizeMapValue(v)
+	case *map[uint64]ByteSize:
+		return newUint64ByteSizeMapValue(v)
+	case *map[string]Rate:
+		return newStringRateMapValue(v)
+	case *map[int]Rate:
+		return newIntRateMapValue(v)
+	case *map[int8]Rate:
+		return newInt8RateMapValue(v)
+	case *map[int16]Rate:
+		return newInt16RateMapValue(v)
+	case *map[int32]Rate:
+		return newInt32RateMapValue(v)
+	case *map[int64]Rate:
+		return newInt64RateMapValue(v)
+	case *map[uint]Rate:
+		return newUintRateMapValue(v)
+	case *map[uint8]Rate:
+		return newUint8RateMapValue(v)
+	case *map[uint16]Rate:
+		return newUint16RateMapValue(v)
+	case *map[uint32]Rate:
+		return newUint32RateMapValue(v)
+	case *map[uint64]Rate:
+		return newUint64RateMapValue(v)
+	case *map[string]netip.Addr:
+		return newStringAddrMapValue(v)
+	case *map[int]netip.Addr:
+		return newIntAddrMapValue(v)
+	case *map[int8]netip.Addr:
+		return newInt8AddrMapValue(v)
+	case *map[int16]netip.Addr:
+		return newInt16AddrMapValue(v)
+	case *map[int32]netip.Addr:
+		return newInt32AddrMapValue(v)
+	case *map[int64]netip.Addr:
+		return newInt64AddrMapValue(v)
+	case *map[uint]netip.Addr:
+		return newUintAddrMapValue(v)
+	case *map[uint8]netip.Addr:
+		return newUint8AddrMapValue(v)
+	case *map[uint16]netip.Addr:
+		return newUint16AddrMapValue(v)
+	case *map[uint32]netip.Addr:
+		return newUint32AddrMapValue(v)
+	case *map[uint64]netip.Addr:
+		return newUint64AddrMapValue(v)
+	case *map[string]netip.Prefix:
+		return newStringPrefixMapValue(v)
+	case *map[int]netip.Prefix:
+		return newIntPrefixMapValue(v)
+	case *map[int8]netip.Prefix:
+		return newInt8PrefixMapValue(v)
+	case *map[int16]netip.Prefix:
+		return newInt16PrefixMapValue(v)
+	case *map[int32]netip.Prefix:
+		return newInt32PrefixMapValue(v)
+	case *map[int64]netip.Prefix:
+		return newInt64PrefixMapValue(v)
+	case *map[uint]netip.Prefix:
+		return newUintPrefixMapValue(v)
+	case *map[uint8]netip.Prefix:
+		return newUint8PrefixMapValue(v)
+	case *map[uint16]netip.Prefix:
+		return newUint16PrefixMapValue(v)
+	case *map[uint32]netip.Prefix:
+		return newUint32PrefixMapValue(v)
+	case *map[uint64]netip.Prefix:
+		return newUint64PrefixMapValue(v)
+	case *map[string]netip.AddrPort:
+		return newStringAddrPortMapValue(v)
+	case *map[int]netip.AddrPort:
+		return newIntAddrPortMapValue(v)
+	case *map[int8]netip.AddrPort:
+		return newInt8AddrPortMapValue(v)
+	case *map[int16]netip.AddrPort:
+		return newInt16AddrPortMapValue(v)
+	case *map[int32]netip.AddrPort:
+		return newInt32AddrPortMapValue(v)
+	case *map[int64]netip.AddrPort:
+		return newInt64AddrPortMapValue(v)
+	case *map[uint]netip.AddrPort:
+		return newUintAddrPortMapValue(v)
+	case *map[uint8]netip.AddrPort:
+		return newUint8AddrPortMapValue(v)
+	case *map[uint16]netip.AddrPort:
+		return newUint16AddrPortMapValue(v)
+	case *map[uint32]netip.AddrPort:
+		return newUint32AddrPortMapValue(v)
+	case *map[uint64]netip.AddrPort:
+		return newUint64AddrPortMapValue(v)
+	default:
+		return nil
+	}
+}
+
+func parseGeneratedArray(value reflect.Value) Value {
+	if value.Kind() != reflect.Array || value.Len() == 0 || !value.CanAddr() {
+		return nil
+	}
+	switch value.Index(0).Addr().Interface().(type) {
+	case *string:
+		return newStringArrayValue(value)
+	case *bool:
+		return newBoolArrayValue(value)
+	case *uint:
+		return newUintArrayValue(value)
+	case *uint8:
+		return newUint8ArrayValue(value)
+	case *uint16:
+		return newUint16ArrayValue(value)
+	case *uint32:
+		return newUint32ArrayValue(value)
+	case *uint64:
+		return newUint64ArrayValue(value)
+	case *int:
+		return newIntArrayValue(value)
+	case *int8:
+		return newInt8ArrayValue(value)
+	case *int16:
+		return newInt16ArrayValue(value)
+	case *int32:
+		return newInt32ArrayValue(value)
+	case *int64:
+		return newInt64ArrayValue(value)
+	case *float64:
+		return newFloat64ArrayValue(value)
+	case *float32:
+		return newFloat32ArrayValue(value)
+	case *time.Duration:
+		return newDurationArrayValue(value)
+	case *net.IP:
+		return newIPArrayValue(value)
+	case *HexBytes:
+		return newHexBytesArrayValue(value)
+	case **regexp.Regexp:
+		return newRegexpArrayValue(value)
+	case *net.TCPAddr:
+		return newTCPAddrArrayValue(value)
+	case *net.IPNet:
+		return newIPNetArrayValue(value)
+	case *url.URL:
+		return newURLArrayValue(value)
+	case *ByteSize:
+		return newByteSizeArrayValue(value)
+	case *Rate:
+		return newRateArrayValue(value)
+	case *ExistingFile:
+		return newExistingFileArrayValue(value)
+	case *ExistingDir:
+		return newExistingDirArrayValue(value)
+	case *File:
+		return newFileArrayValue(value)
+	case *time.Time:
+		return newTimeArrayValue(value)
+	case *netip.Addr:
+		return newAddrArrayValue(value)
+	case *netip.Prefix:
+		return newPrefixArrayValue(value)
+	case *netip.AddrPort:
+		return newAddrPortArrayValue(value)
 	default:
 		return nil
 	}
@@ -586,6 +829,7 @@ func (v *stringValue) Type() string { return "string" }
 type stringSliceValue struct {
 	value   *[]string
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*stringSliceValue)(nil)
@@ -598,8 +842,13 @@ func newStringSliceValue(slice *[]string) *stringSliceValue {
 	}
 }
 
+func (v *stringSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 	out := ss
 	if !v.changed {
 		*v.value = out
@@ -637,6 +886,7 @@ func (v *stringSliceValue) IsCumulative() bool {
 // -- stringStringMapValue
 type stringStringMapValue struct {
 	value *map[string]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringStringMapValue)(nil)
@@ -649,10 +899,12 @@ func newStringStringMapValue(m *map[string]string) *stringStringMapValue {
 	}
 }
 
+func (v *stringStringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringStringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -691,6 +943,7 @@ func (v *stringStringMapValue) IsCumulative() bool {
 // -- intStringMapValue
 type intStringMapValue struct {
 	value *map[int]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intStringMapValue)(nil)
@@ -703,10 +956,12 @@ func newIntStringMapValue(m *map[int]string) *intStringMapValue {
 	}
 }
 
+func (v *intStringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intStringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -750,6 +1005,7 @@ func (v *intStringMapValue) IsCumulative() bool {
 // -- int8StringMapValue
 type int8StringMapValue struct {
 	value *map[int8]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8StringMapValue)(nil)
@@ -762,10 +1018,12 @@ func newInt8StringMapValue(m *map[int8]string) *int8StringMapValue {
 	}
 }
 
+func (v *int8StringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8StringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -809,6 +1067,7 @@ func (v *int8StringMapValue) IsCumulative() bool {
 // -- int16StringMapValue
 type int16StringMapValue struct {
 	value *map[int16]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16StringMapValue)(nil)
@@ -821,10 +1080,12 @@ func newInt16StringMapValue(m *map[int16]string) *int16StringMapValue {
 	}
 }
 
+func (v *int16StringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16StringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -868,6 +1129,7 @@ func (v *int16StringMapValue) IsCumulative() bool {
 // -- int32StringMapValue
 type int32StringMapValue struct {
 	value *map[int32]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32StringMapValue)(nil)
@@ -880,10 +1142,12 @@ func newInt32StringMapValue(m *map[int32]string) *int32StringMapValue {
 	}
 }
 
+func (v *int32StringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32StringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -927,6 +1191,7 @@ func (v *int32StringMapValue) IsCumulative() bool {
 // -- int64StringMapValue
 type int64StringMapValue struct {
 	value *map[int64]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64StringMapValue)(nil)
@@ -939,10 +1204,12 @@ func newInt64StringMapValue(m *map[int64]string) *int64StringMapValue {
 	}
 }
 
+func (v *int64StringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64StringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -986,6 +1253,7 @@ func (v *int64StringMapValue) IsCumulative() bool {
 // -- uintStringMapValue
 type uintStringMapValue struct {
 	value *map[uint]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintStringMapValue)(nil)
@@ -998,10 +1266,12 @@ func newUintStringMapValue(m *map[uint]string) *uintStringMapValue {
 	}
 }
 
+func (v *uintStringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintStringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1045,6 +1315,7 @@ func (v *uintStringMapValue) IsCumulative() bool {
 // -- uint8StringMapValue
 type uint8StringMapValue struct {
 	value *map[uint8]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8StringMapValue)(nil)
@@ -1057,10 +1328,12 @@ func newUint8StringMapValue(m *map[uint8]string) *uint8StringMapValue {
 	}
 }
 
+func (v *uint8StringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8StringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1104,6 +1377,7 @@ func (v *uint8StringMapValue) IsCumulative() bool {
 // -- uint16StringMapValue
 type uint16StringMapValue struct {
 	value *map[uint16]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16StringMapValue)(nil)
@@ -1116,10 +1390,12 @@ func newUint16StringMapValue(m *map[uint16]string) *uint16StringMapValue {
 	}
 }
 
+func (v *uint16StringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16StringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1163,6 +1439,7 @@ func (v *uint16StringMapValue) IsCumulative() bool {
 // -- uint32StringMapValue
 type uint32StringMapValue struct {
 	value *map[uint32]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32StringMapValue)(nil)
@@ -1175,10 +1452,12 @@ func newUint32StringMapValue(m *map[uint32]string) *uint32StringMapValue {
 	}
 }
 
+func (v *uint32StringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32StringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1222,6 +1501,7 @@ func (v *uint32StringMapValue) IsCumulative() bool {
 // -- uint64StringMapValue
 type uint64StringMapValue struct {
 	value *map[uint64]string
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64StringMapValue)(nil)
@@ -1234,10 +1514,12 @@ func newUint64StringMapValue(m *map[uint64]string) *uint64StringMapValue {
 	}
 }
 
+func (v *uint64StringMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64StringMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1320,6 +1602,7 @@ func (v *boolValue) Type() string { return "bool" }
 type boolSliceValue struct {
 	value   *[]bool
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*boolSliceValue)(nil)
@@ -1332,8 +1615,13 @@ func newBoolSliceValue(slice *[]bool) *boolSliceValue {
 	}
 }
 
+func (v *boolSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *boolSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]bool, len(ss))
 	for i, s := range ss {
@@ -1380,6 +1668,7 @@ func (v *boolSliceValue) IsCumulative() bool {
 // -- stringBoolMapValue
 type stringBoolMapValue struct {
 	value *map[string]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringBoolMapValue)(nil)
@@ -1392,10 +1681,12 @@ func newStringBoolMapValue(m *map[string]bool) *stringBoolMapValue {
 	}
 }
 
+func (v *stringBoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringBoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1439,6 +1730,7 @@ func (v *stringBoolMapValue) IsCumulative() bool {
 // -- intBoolMapValue
 type intBoolMapValue struct {
 	value *map[int]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intBoolMapValue)(nil)
@@ -1451,10 +1743,12 @@ func newIntBoolMapValue(m *map[int]bool) *intBoolMapValue {
 	}
 }
 
+func (v *intBoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intBoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1503,6 +1797,7 @@ func (v *intBoolMapValue) IsCumulative() bool {
 // -- int8BoolMapValue
 type int8BoolMapValue struct {
 	value *map[int8]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8BoolMapValue)(nil)
@@ -1515,10 +1810,12 @@ func newInt8BoolMapValue(m *map[int8]bool) *int8BoolMapValue {
 	}
 }
 
+func (v *int8BoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8BoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1567,6 +1864,7 @@ func (v *int8BoolMapValue) IsCumulative() bool {
 // -- int16BoolMapValue
 type int16BoolMapValue struct {
 	value *map[int16]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16BoolMapValue)(nil)
@@ -1579,10 +1877,12 @@ func newInt16BoolMapValue(m *map[int16]bool) *int16BoolMapValue {
 	}
 }
 
+func (v *int16BoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16BoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1631,6 +1931,7 @@ func (v *int16BoolMapValue) IsCumulative() bool {
 // -- int32BoolMapValue
 type int32BoolMapValue struct {
 	value *map[int32]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32BoolMapValue)(nil)
@@ -1643,10 +1944,12 @@ func newInt32BoolMapValue(m *map[int32]bool) *int32BoolMapValue {
 	}
 }
 
+func (v *int32BoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32BoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1695,6 +1998,7 @@ func (v *int32BoolMapValue) IsCumulative() bool {
 // -- int64BoolMapValue
 type int64BoolMapValue struct {
 	value *map[int64]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64BoolMapValue)(nil)
@@ -1707,10 +2011,12 @@ func newInt64BoolMapValue(m *map[int64]bool) *int64BoolMapValue {
 	}
 }
 
+func (v *int64BoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64BoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1759,6 +2065,7 @@ func (v *int64BoolMapValue) IsCumulative() bool {
 // -- uintBoolMapValue
 type uintBoolMapValue struct {
 	value *map[uint]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintBoolMapValue)(nil)
@@ -1771,10 +2078,12 @@ func newUintBoolMapValue(m *map[uint]bool) *uintBoolMapValue {
 	}
 }
 
+func (v *uintBoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintBoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1823,6 +2132,7 @@ func (v *uintBoolMapValue) IsCumulative() bool {
 // -- uint8BoolMapValue
 type uint8BoolMapValue struct {
 	value *map[uint8]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8BoolMapValue)(nil)
@@ -1835,10 +2145,12 @@ func newUint8BoolMapValue(m *map[uint8]bool) *uint8BoolMapValue {
 	}
 }
 
+func (v *uint8BoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8BoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1887,6 +2199,7 @@ func (v *uint8BoolMapValue) IsCumulative() bool {
 // -- uint16BoolMapValue
 type uint16BoolMapValue struct {
 	value *map[uint16]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16BoolMapValue)(nil)
@@ -1899,10 +2212,12 @@ func newUint16BoolMapValue(m *map[uint16]bool) *uint16BoolMapValue {
 	}
 }
 
+func (v *uint16BoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16BoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -1951,6 +2266,7 @@ func (v *uint16BoolMapValue) IsCumulative() bool {
 // -- uint32BoolMapValue
 type uint32BoolMapValue struct {
 	value *map[uint32]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32BoolMapValue)(nil)
@@ -1963,10 +2279,12 @@ func newUint32BoolMapValue(m *map[uint32]bool) *uint32BoolMapValue {
 	}
 }
 
+func (v *uint32BoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32BoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2015,6 +2333,7 @@ func (v *uint32BoolMapValue) IsCumulative() bool {
 // -- uint64BoolMapValue
 type uint64BoolMapValue struct {
 	value *map[uint64]bool
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64BoolMapValue)(nil)
@@ -2027,10 +2346,12 @@ func newUint64BoolMapValue(m *map[uint64]bool) *uint64BoolMapValue {
 	}
 }
 
+func (v *uint64BoolMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64BoolMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2118,6 +2439,7 @@ func (v *uintValue) Type() string { return "uint" }
 type uintSliceValue struct {
 	value   *[]uint
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*uintSliceValue)(nil)
@@ -2130,8 +2452,13 @@ func newUintSliceValue(slice *[]uint) *uintSliceValue {
 	}
 }
 
+func (v *uintSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint, len(ss))
 	for i, s := range ss {
@@ -2178,6 +2505,7 @@ func (v *uintSliceValue) IsCumulative() bool {
 // -- stringUintMapValue
 type stringUintMapValue struct {
 	value *map[string]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringUintMapValue)(nil)
@@ -2190,10 +2518,12 @@ func newStringUintMapValue(m *map[string]uint) *stringUintMapValue {
 	}
 }
 
+func (v *stringUintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringUintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2237,6 +2567,7 @@ func (v *stringUintMapValue) IsCumulative() bool {
 // -- intUintMapValue
 type intUintMapValue struct {
 	value *map[int]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intUintMapValue)(nil)
@@ -2249,10 +2580,12 @@ func newIntUintMapValue(m *map[int]uint) *intUintMapValue {
 	}
 }
 
+func (v *intUintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intUintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2301,6 +2634,7 @@ func (v *intUintMapValue) IsCumulative() bool {
 // -- int8UintMapValue
 type int8UintMapValue struct {
 	value *map[int8]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8UintMapValue)(nil)
@@ -2313,10 +2647,12 @@ func newInt8UintMapValue(m *map[int8]uint) *int8UintMapValue {
 	}
 }
 
+func (v *int8UintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8UintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2365,6 +2701,7 @@ func (v *int8UintMapValue) IsCumulative() bool {
 // -- int16UintMapValue
 type int16UintMapValue struct {
 	value *map[int16]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16UintMapValue)(nil)
@@ -2377,10 +2714,12 @@ func newInt16UintMapValue(m *map[int16]uint) *int16UintMapValue {
 	}
 }
 
+func (v *int16UintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16UintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2429,6 +2768,7 @@ func (v *int16UintMapValue) IsCumulative() bool {
 // -- int32UintMapValue
 type int32UintMapValue struct {
 	value *map[int32]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32UintMapValue)(nil)
@@ -2441,10 +2781,12 @@ func newInt32UintMapValue(m *map[int32]uint) *int32UintMapValue {
 	}
 }
 
+func (v *int32UintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32UintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2493,6 +2835,7 @@ func (v *int32UintMapValue) IsCumulative() bool {
 // -- int64UintMapValue
 type int64UintMapValue struct {
 	value *map[int64]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64UintMapValue)(nil)
@@ -2505,10 +2848,12 @@ func newInt64UintMapValue(m *map[int64]uint) *int64UintMapValue {
 	}
 }
 
+func (v *int64UintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64UintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2557,6 +2902,7 @@ func (v *int64UintMapValue) IsCumulative() bool {
 // -- uintUintMapValue
 type uintUintMapValue struct {
 	value *map[uint]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintUintMapValue)(nil)
@@ -2569,10 +2915,12 @@ func newUintUintMapValue(m *map[uint]uint) *uintUintMapValue {
 	}
 }
 
+func (v *uintUintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintUintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2621,6 +2969,7 @@ func (v *uintUintMapValue) IsCumulative() bool {
 // -- uint8UintMapValue
 type uint8UintMapValue struct {
 	value *map[uint8]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8UintMapValue)(nil)
@@ -2633,10 +2982,12 @@ func newUint8UintMapValue(m *map[uint8]uint) *uint8UintMapValue {
 	}
 }
 
+func (v *uint8UintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8UintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2685,6 +3036,7 @@ func (v *uint8UintMapValue) IsCumulative() bool {
 // -- uint16UintMapValue
 type uint16UintMapValue struct {
 	value *map[uint16]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16UintMapValue)(nil)
@@ -2697,10 +3049,12 @@ func newUint16UintMapValue(m *map[uint16]uint) *uint16UintMapValue {
 	}
 }
 
+func (v *uint16UintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16UintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2749,6 +3103,7 @@ func (v *uint16UintMapValue) IsCumulative() bool {
 // -- uint32UintMapValue
 type uint32UintMapValue struct {
 	value *map[uint32]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32UintMapValue)(nil)
@@ -2761,10 +3116,12 @@ func newUint32UintMapValue(m *map[uint32]uint) *uint32UintMapValue {
 	}
 }
 
+func (v *uint32UintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32UintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2813,6 +3170,7 @@ func (v *uint32UintMapValue) IsCumulative() bool {
 // -- uint64UintMapValue
 type uint64UintMapValue struct {
 	value *map[uint64]uint
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64UintMapValue)(nil)
@@ -2825,10 +3183,12 @@ func newUint64UintMapValue(m *map[uint64]uint) *uint64UintMapValue {
 	}
 }
 
+func (v *uint64UintMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64UintMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -2916,6 +3276,7 @@ func (v *uint8Value) Type() string { return "uint8" }
 type uint8SliceValue struct {
 	value   *[]uint8
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*uint8SliceValue)(nil)
@@ -2928,8 +3289,13 @@ func newUint8SliceValue(slice *[]uint8) *uint8SliceValue {
 	}
 }
 
+func (v *uint8SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint8, len(ss))
 	for i, s := range ss {
@@ -2976,6 +3342,7 @@ func (v *uint8SliceValue) IsCumulative() bool {
 // -- stringUint8MapValue
 type stringUint8MapValue struct {
 	value *map[string]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringUint8MapValue)(nil)
@@ -2988,10 +3355,12 @@ func newStringUint8MapValue(m *map[string]uint8) *stringUint8MapValue {
 	}
 }
 
+func (v *stringUint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringUint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3035,6 +3404,7 @@ func (v *stringUint8MapValue) IsCumulative() bool {
 // -- intUint8MapValue
 type intUint8MapValue struct {
 	value *map[int]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intUint8MapValue)(nil)
@@ -3047,10 +3417,12 @@ func newIntUint8MapValue(m *map[int]uint8) *intUint8MapValue {
 	}
 }
 
+func (v *intUint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intUint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3099,6 +3471,7 @@ func (v *intUint8MapValue) IsCumulative() bool {
 // -- int8Uint8MapValue
 type int8Uint8MapValue struct {
 	value *map[int8]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Uint8MapValue)(nil)
@@ -3111,10 +3484,12 @@ func newInt8Uint8MapValue(m *map[int8]uint8) *int8Uint8MapValue {
 	}
 }
 
+func (v *int8Uint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Uint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3163,6 +3538,7 @@ func (v *int8Uint8MapValue) IsCumulative() bool {
 // -- int16Uint8MapValue
 type int16Uint8MapValue struct {
 	value *map[int16]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Uint8MapValue)(nil)
@@ -3175,10 +3551,12 @@ func newInt16Uint8MapValue(m *map[int16]uint8) *int16Uint8MapValue {
 	}
 }
 
+func (v *int16Uint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Uint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3227,6 +3605,7 @@ func (v *int16Uint8MapValue) IsCumulative() bool {
 // -- int32Uint8MapValue
 type int32Uint8MapValue struct {
 	value *map[int32]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Uint8MapValue)(nil)
@@ -3239,10 +3618,12 @@ func newInt32Uint8MapValue(m *map[int32]uint8) *int32Uint8MapValue {
 	}
 }
 
+func (v *int32Uint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Uint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3291,6 +3672,7 @@ func (v *int32Uint8MapValue) IsCumulative() bool {
 // -- int64Uint8MapValue
 type int64Uint8MapValue struct {
 	value *map[int64]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Uint8MapValue)(nil)
@@ -3303,10 +3685,12 @@ func newInt64Uint8MapValue(m *map[int64]uint8) *int64Uint8MapValue {
 	}
 }
 
+func (v *int64Uint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Uint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3355,6 +3739,7 @@ func (v *int64Uint8MapValue) IsCumulative() bool {
 // -- uintUint8MapValue
 type uintUint8MapValue struct {
 	value *map[uint]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintUint8MapValue)(nil)
@@ -3367,10 +3752,12 @@ func newUintUint8MapValue(m *map[uint]uint8) *uintUint8MapValue {
 	}
 }
 
+func (v *uintUint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintUint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3419,6 +3806,7 @@ func (v *uintUint8MapValue) IsCumulative() bool {
 // -- uint8Uint8MapValue
 type uint8Uint8MapValue struct {
 	value *map[uint8]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Uint8MapValue)(nil)
@@ -3431,10 +3819,12 @@ func newUint8Uint8MapValue(m *map[uint8]uint8) *uint8Uint8MapValue {
 	}
 }
 
+func (v *uint8Uint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Uint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3483,6 +3873,7 @@ func (v *uint8Uint8MapValue) IsCumulative() bool {
 // -- uint16Uint8MapValue
 type uint16Uint8MapValue struct {
 	value *map[uint16]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Uint8MapValue)(nil)
@@ -3495,10 +3886,12 @@ func newUint16Uint8MapValue(m *map[uint16]uint8) *uint16Uint8MapValue {
 	}
 }
 
+func (v *uint16Uint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Uint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3547,6 +3940,7 @@ func (v *uint16Uint8MapValue) IsCumulative() bool {
 // -- uint32Uint8MapValue
 type uint32Uint8MapValue struct {
 	value *map[uint32]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Uint8MapValue)(nil)
@@ -3559,10 +3953,12 @@ func newUint32Uint8MapValue(m *map[uint32]uint8) *uint32Uint8MapValue {
 	}
 }
 
+func (v *uint32Uint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Uint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3611,6 +4007,7 @@ func (v *uint32Uint8MapValue) IsCumulative() bool {
 // -- uint64Uint8MapValue
 type uint64Uint8MapValue struct {
 	value *map[uint64]uint8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Uint8MapValue)(nil)
@@ -3623,10 +4020,12 @@ func newUint64Uint8MapValue(m *map[uint64]uint8) *uint64Uint8MapValue {
 	}
 }
 
+func (v *uint64Uint8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Uint8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3714,6 +4113,7 @@ func (v *uint16Value) Type() string { return "uint16" }
 type uint16SliceValue struct {
 	value   *[]uint16
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*uint16SliceValue)(nil)
@@ -3726,8 +4126,13 @@ func newUint16SliceValue(slice *[]uint16) *uint16SliceValue {
 	}
 }
 
+func (v *uint16SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint16, len(ss))
 	for i, s := range ss {
@@ -3774,6 +4179,7 @@ func (v *uint16SliceValue) IsCumulative() bool {
 // -- stringUint16MapValue
 type stringUint16MapValue struct {
 	value *map[string]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringUint16MapValue)(nil)
@@ -3786,10 +4192,12 @@ func newStringUint16MapValue(m *map[string]uint16) *stringUint16MapValue {
 	}
 }
 
+func (v *stringUint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringUint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3833,6 +4241,7 @@ func (v *stringUint16MapValue) IsCumulative() bool {
 // -- intUint16MapValue
 type intUint16MapValue struct {
 	value *map[int]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intUint16MapValue)(nil)
@@ -3845,10 +4254,12 @@ func newIntUint16MapValue(m *map[int]uint16) *intUint16MapValue {
 	}
 }
 
+func (v *intUint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intUint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3897,6 +4308,7 @@ func (v *intUint16MapValue) IsCumulative() bool {
 // -- int8Uint16MapValue
 type int8Uint16MapValue struct {
 	value *map[int8]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Uint16MapValue)(nil)
@@ -3909,10 +4321,12 @@ func newInt8Uint16MapValue(m *map[int8]uint16) *int8Uint16MapValue {
 	}
 }
 
+func (v *int8Uint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Uint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -3961,6 +4375,7 @@ func (v *int8Uint16MapValue) IsCumulative() bool {
 // -- int16Uint16MapValue
 type int16Uint16MapValue struct {
 	value *map[int16]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Uint16MapValue)(nil)
@@ -3973,10 +4388,12 @@ func newInt16Uint16MapValue(m *map[int16]uint16) *int16Uint16MapValue {
 	}
 }
 
+func (v *int16Uint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Uint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4025,6 +4442,7 @@ func (v *int16Uint16MapValue) IsCumulative() bool {
 // -- int32Uint16MapValue
 type int32Uint16MapValue struct {
 	value *map[int32]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Uint16MapValue)(nil)
@@ -4037,10 +4455,12 @@ func newInt32Uint16MapValue(m *map[int32]uint16) *int32Uint16MapValue {
 	}
 }
 
+func (v *int32Uint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Uint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4089,6 +4509,7 @@ func (v *int32Uint16MapValue) IsCumulative() bool {
 // -- int64Uint16MapValue
 type int64Uint16MapValue struct {
 	value *map[int64]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Uint16MapValue)(nil)
@@ -4101,10 +4522,12 @@ func newInt64Uint16MapValue(m *map[int64]uint16) *int64Uint16MapValue {
 	}
 }
 
+func (v *int64Uint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Uint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4153,6 +4576,7 @@ func (v *int64Uint16MapValue) IsCumulative() bool {
 // -- uintUint16MapValue
 type uintUint16MapValue struct {
 	value *map[uint]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintUint16MapValue)(nil)
@@ -4165,10 +4589,12 @@ func newUintUint16MapValue(m *map[uint]uint16) *uintUint16MapValue {
 	}
 }
 
+func (v *uintUint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintUint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4217,6 +4643,7 @@ func (v *uintUint16MapValue) IsCumulative() bool {
 // -- uint8Uint16MapValue
 type uint8Uint16MapValue struct {
 	value *map[uint8]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Uint16MapValue)(nil)
@@ -4229,10 +4656,12 @@ func newUint8Uint16MapValue(m *map[uint8]uint16) *uint8Uint16MapValue {
 	}
 }
 
+func (v *uint8Uint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Uint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4281,6 +4710,7 @@ func (v *uint8Uint16MapValue) IsCumulative() bool {
 // -- uint16Uint16MapValue
 type uint16Uint16MapValue struct {
 	value *map[uint16]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Uint16MapValue)(nil)
@@ -4293,10 +4723,12 @@ func newUint16Uint16MapValue(m *map[uint16]uint16) *uint16Uint16MapValue {
 	}
 }
 
+func (v *uint16Uint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Uint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4345,6 +4777,7 @@ func (v *uint16Uint16MapValue) IsCumulative() bool {
 // -- uint32Uint16MapValue
 type uint32Uint16MapValue struct {
 	value *map[uint32]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Uint16MapValue)(nil)
@@ -4357,10 +4790,12 @@ func newUint32Uint16MapValue(m *map[uint32]uint16) *uint32Uint16MapValue {
 	}
 }
 
+func (v *uint32Uint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Uint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4409,6 +4844,7 @@ func (v *uint32Uint16MapValue) IsCumulative() bool {
 // -- uint64Uint16MapValue
 type uint64Uint16MapValue struct {
 	value *map[uint64]uint16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Uint16MapValue)(nil)
@@ -4421,10 +4857,12 @@ func newUint64Uint16MapValue(m *map[uint64]uint16) *uint64Uint16MapValue {
 	}
 }
 
+func (v *uint64Uint16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Uint16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4512,6 +4950,7 @@ func (v *uint32Value) Type() string { return "uint32" }
 type uint32SliceValue struct {
 	value   *[]uint32
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*uint32SliceValue)(nil)
@@ -4524,8 +4963,13 @@ func newUint32SliceValue(slice *[]uint32) *uint32SliceValue {
 	}
 }
 
+func (v *uint32SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint32, len(ss))
 	for i, s := range ss {
@@ -4572,6 +5016,7 @@ func (v *uint32SliceValue) IsCumulative() bool {
 // -- stringUint32MapValue
 type stringUint32MapValue struct {
 	value *map[string]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringUint32MapValue)(nil)
@@ -4584,10 +5029,12 @@ func newStringUint32MapValue(m *map[string]uint32) *stringUint32MapValue {
 	}
 }
 
+func (v *stringUint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringUint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4631,6 +5078,7 @@ func (v *stringUint32MapValue) IsCumulative() bool {
 // -- intUint32MapValue
 type intUint32MapValue struct {
 	value *map[int]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intUint32MapValue)(nil)
@@ -4643,10 +5091,12 @@ func newIntUint32MapValue(m *map[int]uint32) *intUint32MapValue {
 	}
 }
 
+func (v *intUint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intUint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4695,6 +5145,7 @@ func (v *intUint32MapValue) IsCumulative() bool {
 // -- int8Uint32MapValue
 type int8Uint32MapValue struct {
 	value *map[int8]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Uint32MapValue)(nil)
@@ -4707,10 +5158,12 @@ func newInt8Uint32MapValue(m *map[int8]uint32) *int8Uint32MapValue {
 	}
 }
 
+func (v *int8Uint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Uint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4759,6 +5212,7 @@ func (v *int8Uint32MapValue) IsCumulative() bool {
 // -- int16Uint32MapValue
 type int16Uint32MapValue struct {
 	value *map[int16]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Uint32MapValue)(nil)
@@ -4771,10 +5225,12 @@ func newInt16Uint32MapValue(m *map[int16]uint32) *int16Uint32MapValue {
 	}
 }
 
+func (v *int16Uint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Uint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4823,6 +5279,7 @@ func (v *int16Uint32MapValue) IsCumulative() bool {
 // -- int32Uint32MapValue
 type int32Uint32MapValue struct {
 	value *map[int32]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Uint32MapValue)(nil)
@@ -4835,10 +5292,12 @@ func newInt32Uint32MapValue(m *map[int32]uint32) *int32Uint32MapValue {
 	}
 }
 
+func (v *int32Uint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Uint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4887,6 +5346,7 @@ func (v *int32Uint32MapValue) IsCumulative() bool {
 // -- int64Uint32MapValue
 type int64Uint32MapValue struct {
 	value *map[int64]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Uint32MapValue)(nil)
@@ -4899,10 +5359,12 @@ func newInt64Uint32MapValue(m *map[int64]uint32) *int64Uint32MapValue {
 	}
 }
 
+func (v *int64Uint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Uint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -4951,6 +5413,7 @@ func (v *int64Uint32MapValue) IsCumulative() bool {
 // -- uintUint32MapValue
 type uintUint32MapValue struct {
 	value *map[uint]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintUint32MapValue)(nil)
@@ -4963,10 +5426,12 @@ func newUintUint32MapValue(m *map[uint]uint32) *uintUint32MapValue {
 	}
 }
 
+func (v *uintUint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintUint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5015,6 +5480,7 @@ func (v *uintUint32MapValue) IsCumulative() bool {
 // -- uint8Uint32MapValue
 type uint8Uint32MapValue struct {
 	value *map[uint8]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Uint32MapValue)(nil)
@@ -5027,10 +5493,12 @@ func newUint8Uint32MapValue(m *map[uint8]uint32) *uint8Uint32MapValue {
 	}
 }
 
+func (v *uint8Uint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Uint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5079,6 +5547,7 @@ func (v *uint8Uint32MapValue) IsCumulative() bool {
 // -- uint16Uint32MapValue
 type uint16Uint32MapValue struct {
 	value *map[uint16]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Uint32MapValue)(nil)
@@ -5091,10 +5560,12 @@ func newUint16Uint32MapValue(m *map[uint16]uint32) *uint16Uint32MapValue {
 	}
 }
 
+func (v *uint16Uint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Uint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5143,6 +5614,7 @@ func (v *uint16Uint32MapValue) IsCumulative() bool {
 // -- uint32Uint32MapValue
 type uint32Uint32MapValue struct {
 	value *map[uint32]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Uint32MapValue)(nil)
@@ -5155,10 +5627,12 @@ func newUint32Uint32MapValue(m *map[uint32]uint32) *uint32Uint32MapValue {
 	}
 }
 
+func (v *uint32Uint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Uint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5207,6 +5681,7 @@ func (v *uint32Uint32MapValue) IsCumulative() bool {
 // -- uint64Uint32MapValue
 type uint64Uint32MapValue struct {
 	value *map[uint64]uint32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Uint32MapValue)(nil)
@@ -5219,10 +5694,12 @@ func newUint64Uint32MapValue(m *map[uint64]uint32) *uint64Uint32MapValue {
 	}
 }
 
+func (v *uint64Uint32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Uint32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5310,6 +5787,7 @@ func (v *uint64Value) Type() string { return "uint64" }
 type uint64SliceValue struct {
 	value   *[]uint64
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*uint64SliceValue)(nil)
@@ -5322,8 +5800,13 @@ func newUint64SliceValue(slice *[]uint64) *uint64SliceValue {
 	}
 }
 
+func (v *uint64SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]uint64, len(ss))
 	for i, s := range ss {
@@ -5370,6 +5853,7 @@ func (v *uint64SliceValue) IsCumulative() bool {
 // -- stringUint64MapValue
 type stringUint64MapValue struct {
 	value *map[string]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringUint64MapValue)(nil)
@@ -5382,10 +5866,12 @@ func newStringUint64MapValue(m *map[string]uint64) *stringUint64MapValue {
 	}
 }
 
+func (v *stringUint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringUint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5429,6 +5915,7 @@ func (v *stringUint64MapValue) IsCumulative() bool {
 // -- intUint64MapValue
 type intUint64MapValue struct {
 	value *map[int]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intUint64MapValue)(nil)
@@ -5441,10 +5928,12 @@ func newIntUint64MapValue(m *map[int]uint64) *intUint64MapValue {
 	}
 }
 
+func (v *intUint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intUint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5493,6 +5982,7 @@ func (v *intUint64MapValue) IsCumulative() bool {
 // -- int8Uint64MapValue
 type int8Uint64MapValue struct {
 	value *map[int8]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Uint64MapValue)(nil)
@@ -5505,10 +5995,12 @@ func newInt8Uint64MapValue(m *map[int8]uint64) *int8Uint64MapValue {
 	}
 }
 
+func (v *int8Uint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Uint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5557,6 +6049,7 @@ func (v *int8Uint64MapValue) IsCumulative() bool {
 // -- int16Uint64MapValue
 type int16Uint64MapValue struct {
 	value *map[int16]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Uint64MapValue)(nil)
@@ -5569,10 +6062,12 @@ func newInt16Uint64MapValue(m *map[int16]uint64) *int16Uint64MapValue {
 	}
 }
 
+func (v *int16Uint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Uint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5621,6 +6116,7 @@ func (v *int16Uint64MapValue) IsCumulative() bool {
 // -- int32Uint64MapValue
 type int32Uint64MapValue struct {
 	value *map[int32]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Uint64MapValue)(nil)
@@ -5633,10 +6129,12 @@ func newInt32Uint64MapValue(m *map[int32]uint64) *int32Uint64MapValue {
 	}
 }
 
+func (v *int32Uint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Uint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5685,6 +6183,7 @@ func (v *int32Uint64MapValue) IsCumulative() bool {
 // -- int64Uint64MapValue
 type int64Uint64MapValue struct {
 	value *map[int64]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Uint64MapValue)(nil)
@@ -5697,10 +6196,12 @@ func newInt64Uint64MapValue(m *map[int64]uint64) *int64Uint64MapValue {
 	}
 }
 
+func (v *int64Uint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Uint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5749,6 +6250,7 @@ func (v *int64Uint64MapValue) IsCumulative() bool {
 // -- uintUint64MapValue
 type uintUint64MapValue struct {
 	value *map[uint]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintUint64MapValue)(nil)
@@ -5761,10 +6263,12 @@ func newUintUint64MapValue(m *map[uint]uint64) *uintUint64MapValue {
 	}
 }
 
+func (v *uintUint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintUint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5813,6 +6317,7 @@ func (v *uintUint64MapValue) IsCumulative() bool {
 // -- uint8Uint64MapValue
 type uint8Uint64MapValue struct {
 	value *map[uint8]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Uint64MapValue)(nil)
@@ -5825,10 +6330,12 @@ func newUint8Uint64MapValue(m *map[uint8]uint64) *uint8Uint64MapValue {
 	}
 }
 
+func (v *uint8Uint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Uint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5877,6 +6384,7 @@ func (v *uint8Uint64MapValue) IsCumulative() bool {
 // -- uint16Uint64MapValue
 type uint16Uint64MapValue struct {
 	value *map[uint16]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Uint64MapValue)(nil)
@@ -5889,10 +6397,12 @@ func newUint16Uint64MapValue(m *map[uint16]uint64) *uint16Uint64MapValue {
 	}
 }
 
+func (v *uint16Uint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Uint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -5941,6 +6451,7 @@ func (v *uint16Uint64MapValue) IsCumulative() bool {
 // -- uint32Uint64MapValue
 type uint32Uint64MapValue struct {
 	value *map[uint32]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Uint64MapValue)(nil)
@@ -5953,10 +6464,12 @@ func newUint32Uint64MapValue(m *map[uint32]uint64) *uint32Uint64MapValue {
 	}
 }
 
+func (v *uint32Uint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Uint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6005,6 +6518,7 @@ func (v *uint32Uint64MapValue) IsCumulative() bool {
 // -- uint64Uint64MapValue
 type uint64Uint64MapValue struct {
 	value *map[uint64]uint64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Uint64MapValue)(nil)
@@ -6017,10 +6531,12 @@ func newUint64Uint64MapValue(m *map[uint64]uint64) *uint64Uint64MapValue {
 	}
 }
 
+func (v *uint64Uint64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Uint64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6108,6 +6624,7 @@ func (v *intValue) Type() string { return "int" }
 type intSliceValue struct {
 	value   *[]int
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*intSliceValue)(nil)
@@ -6120,8 +6637,13 @@ func newIntSliceValue(slice *[]int) *intSliceValue {
 	}
 }
 
+func (v *intSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int, len(ss))
 	for i, s := range ss {
@@ -6168,6 +6690,7 @@ func (v *intSliceValue) IsCumulative() bool {
 // -- stringIntMapValue
 type stringIntMapValue struct {
 	value *map[string]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringIntMapValue)(nil)
@@ -6180,10 +6703,12 @@ func newStringIntMapValue(m *map[string]int) *stringIntMapValue {
 	}
 }
 
+func (v *stringIntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringIntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6227,6 +6752,7 @@ func (v *stringIntMapValue) IsCumulative() bool {
 // -- intIntMapValue
 type intIntMapValue struct {
 	value *map[int]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intIntMapValue)(nil)
@@ -6239,10 +6765,12 @@ func newIntIntMapValue(m *map[int]int) *intIntMapValue {
 	}
 }
 
+func (v *intIntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intIntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6291,6 +6819,7 @@ func (v *intIntMapValue) IsCumulative() bool {
 // -- int8IntMapValue
 type int8IntMapValue struct {
 	value *map[int8]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8IntMapValue)(nil)
@@ -6303,10 +6832,12 @@ func newInt8IntMapValue(m *map[int8]int) *int8IntMapValue {
 	}
 }
 
+func (v *int8IntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8IntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6355,6 +6886,7 @@ func (v *int8IntMapValue) IsCumulative() bool {
 // -- int16IntMapValue
 type int16IntMapValue struct {
 	value *map[int16]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16IntMapValue)(nil)
@@ -6367,10 +6899,12 @@ func newInt16IntMapValue(m *map[int16]int) *int16IntMapValue {
 	}
 }
 
+func (v *int16IntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16IntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6419,6 +6953,7 @@ func (v *int16IntMapValue) IsCumulative() bool {
 // -- int32IntMapValue
 type int32IntMapValue struct {
 	value *map[int32]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32IntMapValue)(nil)
@@ -6431,10 +6966,12 @@ func newInt32IntMapValue(m *map[int32]int) *int32IntMapValue {
 	}
 }
 
+func (v *int32IntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32IntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6483,6 +7020,7 @@ func (v *int32IntMapValue) IsCumulative() bool {
 // -- int64IntMapValue
 type int64IntMapValue struct {
 	value *map[int64]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64IntMapValue)(nil)
@@ -6495,10 +7033,12 @@ func newInt64IntMapValue(m *map[int64]int) *int64IntMapValue {
 	}
 }
 
+func (v *int64IntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64IntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6547,6 +7087,7 @@ func (v *int64IntMapValue) IsCumulative() bool {
 // -- uintIntMapValue
 type uintIntMapValue struct {
 	value *map[uint]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintIntMapValue)(nil)
@@ -6559,10 +7100,12 @@ func newUintIntMapValue(m *map[uint]int) *uintIntMapValue {
 	}
 }
 
+func (v *uintIntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintIntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6611,6 +7154,7 @@ func (v *uintIntMapValue) IsCumulative() bool {
 // -- uint8IntMapValue
 type uint8IntMapValue struct {
 	value *map[uint8]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8IntMapValue)(nil)
@@ -6623,10 +7167,12 @@ func newUint8IntMapValue(m *map[uint8]int) *uint8IntMapValue {
 	}
 }
 
+func (v *uint8IntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8IntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6675,6 +7221,7 @@ func (v *uint8IntMapValue) IsCumulative() bool {
 // -- uint16IntMapValue
 type uint16IntMapValue struct {
 	value *map[uint16]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16IntMapValue)(nil)
@@ -6687,10 +7234,12 @@ func newUint16IntMapValue(m *map[uint16]int) *uint16IntMapValue {
 	}
 }
 
+func (v *uint16IntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16IntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6739,6 +7288,7 @@ func (v *uint16IntMapValue) IsCumulative() bool {
 // -- uint32IntMapValue
 type uint32IntMapValue struct {
 	value *map[uint32]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32IntMapValue)(nil)
@@ -6751,10 +7301,12 @@ func newUint32IntMapValue(m *map[uint32]int) *uint32IntMapValue {
 	}
 }
 
+func (v *uint32IntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32IntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6803,6 +7355,7 @@ func (v *uint32IntMapValue) IsCumulative() bool {
 // -- uint64IntMapValue
 type uint64IntMapValue struct {
 	value *map[uint64]int
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64IntMapValue)(nil)
@@ -6815,10 +7368,12 @@ func newUint64IntMapValue(m *map[uint64]int) *uint64IntMapValue {
 	}
 }
 
+func (v *uint64IntMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64IntMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -6906,6 +7461,7 @@ func (v *int8Value) Type() string { return "int8" }
 type int8SliceValue struct {
 	value   *[]int8
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*int8SliceValue)(nil)
@@ -6918,8 +7474,13 @@ func newInt8SliceValue(slice *[]int8) *int8SliceValue {
 	}
 }
 
+func (v *int8SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int8, len(ss))
 	for i, s := range ss {
@@ -6966,6 +7527,7 @@ func (v *int8SliceValue) IsCumulative() bool {
 // -- stringInt8MapValue
 type stringInt8MapValue struct {
 	value *map[string]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringInt8MapValue)(nil)
@@ -6978,10 +7540,12 @@ func newStringInt8MapValue(m *map[string]int8) *stringInt8MapValue {
 	}
 }
 
+func (v *stringInt8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringInt8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7025,6 +7589,7 @@ func (v *stringInt8MapValue) IsCumulative() bool {
 // -- intInt8MapValue
 type intInt8MapValue struct {
 	value *map[int]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intInt8MapValue)(nil)
@@ -7037,10 +7602,12 @@ func newIntInt8MapValue(m *map[int]int8) *intInt8MapValue {
 	}
 }
 
+func (v *intInt8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intInt8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7089,6 +7656,7 @@ func (v *intInt8MapValue) IsCumulative() bool {
 // -- int8Int8MapValue
 type int8Int8MapValue struct {
 	value *map[int8]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Int8MapValue)(nil)
@@ -7101,10 +7669,12 @@ func newInt8Int8MapValue(m *map[int8]int8) *int8Int8MapValue {
 	}
 }
 
+func (v *int8Int8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Int8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7153,6 +7723,7 @@ func (v *int8Int8MapValue) IsCumulative() bool {
 // -- int16Int8MapValue
 type int16Int8MapValue struct {
 	value *map[int16]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Int8MapValue)(nil)
@@ -7165,10 +7736,12 @@ func newInt16Int8MapValue(m *map[int16]int8) *int16Int8MapValue {
 	}
 }
 
+func (v *int16Int8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Int8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7217,6 +7790,7 @@ func (v *int16Int8MapValue) IsCumulative() bool {
 // -- int32Int8MapValue
 type int32Int8MapValue struct {
 	value *map[int32]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Int8MapValue)(nil)
@@ -7229,10 +7803,12 @@ func newInt32Int8MapValue(m *map[int32]int8) *int32Int8MapValue {
 	}
 }
 
+func (v *int32Int8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Int8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7281,6 +7857,7 @@ func (v *int32Int8MapValue) IsCumulative() bool {
 // -- int64Int8MapValue
 type int64Int8MapValue struct {
 	value *map[int64]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Int8MapValue)(nil)
@@ -7293,10 +7870,12 @@ func newInt64Int8MapValue(m *map[int64]int8) *int64Int8MapValue {
 	}
 }
 
+func (v *int64Int8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Int8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7345,6 +7924,7 @@ func (v *int64Int8MapValue) IsCumulative() bool {
 // -- uintInt8MapValue
 type uintInt8MapValue struct {
 	value *map[uint]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintInt8MapValue)(nil)
@@ -7357,10 +7937,12 @@ func newUintInt8MapValue(m *map[uint]int8) *uintInt8MapValue {
 	}
 }
 
+func (v *uintInt8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintInt8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7409,6 +7991,7 @@ func (v *uintInt8MapValue) IsCumulative() bool {
 // -- uint8Int8MapValue
 type uint8Int8MapValue struct {
 	value *map[uint8]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Int8MapValue)(nil)
@@ -7421,10 +8004,12 @@ func newUint8Int8MapValue(m *map[uint8]int8) *uint8Int8MapValue {
 	}
 }
 
+func (v *uint8Int8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Int8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7473,6 +8058,7 @@ func (v *uint8Int8MapValue) IsCumulative() bool {
 // -- uint16Int8MapValue
 type uint16Int8MapValue struct {
 	value *map[uint16]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Int8MapValue)(nil)
@@ -7485,10 +8071,12 @@ func newUint16Int8MapValue(m *map[uint16]int8) *uint16Int8MapValue {
 	}
 }
 
+func (v *uint16Int8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Int8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7537,6 +8125,7 @@ func (v *uint16Int8MapValue) IsCumulative() bool {
 // -- uint32Int8MapValue
 type uint32Int8MapValue struct {
 	value *map[uint32]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Int8MapValue)(nil)
@@ -7549,10 +8138,12 @@ func newUint32Int8MapValue(m *map[uint32]int8) *uint32Int8MapValue {
 	}
 }
 
+func (v *uint32Int8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Int8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7601,6 +8192,7 @@ func (v *uint32Int8MapValue) IsCumulative() bool {
 // -- uint64Int8MapValue
 type uint64Int8MapValue struct {
 	value *map[uint64]int8
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Int8MapValue)(nil)
@@ -7613,10 +8205,12 @@ func newUint64Int8MapValue(m *map[uint64]int8) *uint64Int8MapValue {
 	}
 }
 
+func (v *uint64Int8MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Int8MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7704,6 +8298,7 @@ func (v *int16Value) Type() string { return "int16" }
 type int16SliceValue struct {
 	value   *[]int16
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*int16SliceValue)(nil)
@@ -7716,8 +8311,13 @@ func newInt16SliceValue(slice *[]int16) *int16SliceValue {
 	}
 }
 
+func (v *int16SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int16, len(ss))
 	for i, s := range ss {
@@ -7764,6 +8364,7 @@ func (v *int16SliceValue) IsCumulative() bool {
 // -- stringInt16MapValue
 type stringInt16MapValue struct {
 	value *map[string]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringInt16MapValue)(nil)
@@ -7776,10 +8377,12 @@ func newStringInt16MapValue(m *map[string]int16) *stringInt16MapValue {
 	}
 }
 
+func (v *stringInt16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringInt16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7823,6 +8426,7 @@ func (v *stringInt16MapValue) IsCumulative() bool {
 // -- intInt16MapValue
 type intInt16MapValue struct {
 	value *map[int]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intInt16MapValue)(nil)
@@ -7835,10 +8439,12 @@ func newIntInt16MapValue(m *map[int]int16) *intInt16MapValue {
 	}
 }
 
+func (v *intInt16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intInt16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7887,6 +8493,7 @@ func (v *intInt16MapValue) IsCumulative() bool {
 // -- int8Int16MapValue
 type int8Int16MapValue struct {
 	value *map[int8]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Int16MapValue)(nil)
@@ -7899,10 +8506,12 @@ func newInt8Int16MapValue(m *map[int8]int16) *int8Int16MapValue {
 	}
 }
 
+func (v *int8Int16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Int16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -7951,6 +8560,7 @@ func (v *int8Int16MapValue) IsCumulative() bool {
 // -- int16Int16MapValue
 type int16Int16MapValue struct {
 	value *map[int16]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Int16MapValue)(nil)
@@ -7963,10 +8573,12 @@ func newInt16Int16MapValue(m *map[int16]int16) *int16Int16MapValue {
 	}
 }
 
+func (v *int16Int16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Int16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8015,6 +8627,7 @@ func (v *int16Int16MapValue) IsCumulative() bool {
 // -- int32Int16MapValue
 type int32Int16MapValue struct {
 	value *map[int32]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Int16MapValue)(nil)
@@ -8027,10 +8640,12 @@ func newInt32Int16MapValue(m *map[int32]int16) *int32Int16MapValue {
 	}
 }
 
+func (v *int32Int16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Int16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8079,6 +8694,7 @@ func (v *int32Int16MapValue) IsCumulative() bool {
 // -- int64Int16MapValue
 type int64Int16MapValue struct {
 	value *map[int64]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Int16MapValue)(nil)
@@ -8091,10 +8707,12 @@ func newInt64Int16MapValue(m *map[int64]int16) *int64Int16MapValue {
 	}
 }
 
+func (v *int64Int16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Int16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8143,6 +8761,7 @@ func (v *int64Int16MapValue) IsCumulative() bool {
 // -- uintInt16MapValue
 type uintInt16MapValue struct {
 	value *map[uint]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintInt16MapValue)(nil)
@@ -8155,10 +8774,12 @@ func newUintInt16MapValue(m *map[uint]int16) *uintInt16MapValue {
 	}
 }
 
+func (v *uintInt16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintInt16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8207,6 +8828,7 @@ func (v *uintInt16MapValue) IsCumulative() bool {
 // -- uint8Int16MapValue
 type uint8Int16MapValue struct {
 	value *map[uint8]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Int16MapValue)(nil)
@@ -8219,10 +8841,12 @@ func newUint8Int16MapValue(m *map[uint8]int16) *uint8Int16MapValue {
 	}
 }
 
+func (v *uint8Int16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Int16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8271,6 +8895,7 @@ func (v *uint8Int16MapValue) IsCumulative() bool {
 // -- uint16Int16MapValue
 type uint16Int16MapValue struct {
 	value *map[uint16]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Int16MapValue)(nil)
@@ -8283,10 +8908,12 @@ func newUint16Int16MapValue(m *map[uint16]int16) *uint16Int16MapValue {
 	}
 }
 
+func (v *uint16Int16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Int16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8335,6 +8962,7 @@ func (v *uint16Int16MapValue) IsCumulative() bool {
 // -- uint32Int16MapValue
 type uint32Int16MapValue struct {
 	value *map[uint32]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Int16MapValue)(nil)
@@ -8347,10 +8975,12 @@ func newUint32Int16MapValue(m *map[uint32]int16) *uint32Int16MapValue {
 	}
 }
 
+func (v *uint32Int16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Int16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8399,6 +9029,7 @@ func (v *uint32Int16MapValue) IsCumulative() bool {
 // -- uint64Int16MapValue
 type uint64Int16MapValue struct {
 	value *map[uint64]int16
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Int16MapValue)(nil)
@@ -8411,10 +9042,12 @@ func newUint64Int16MapValue(m *map[uint64]int16) *uint64Int16MapValue {
 	}
 }
 
+func (v *uint64Int16MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Int16MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8502,6 +9135,7 @@ func (v *int32Value) Type() string { return "int32" }
 type int32SliceValue struct {
 	value   *[]int32
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*int32SliceValue)(nil)
@@ -8514,8 +9148,13 @@ func newInt32SliceValue(slice *[]int32) *int32SliceValue {
 	}
 }
 
+func (v *int32SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int32, len(ss))
 	for i, s := range ss {
@@ -8562,6 +9201,7 @@ func (v *int32SliceValue) IsCumulative() bool {
 // -- stringInt32MapValue
 type stringInt32MapValue struct {
 	value *map[string]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringInt32MapValue)(nil)
@@ -8574,10 +9214,12 @@ func newStringInt32MapValue(m *map[string]int32) *stringInt32MapValue {
 	}
 }
 
+func (v *stringInt32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringInt32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8621,6 +9263,7 @@ func (v *stringInt32MapValue) IsCumulative() bool {
 // -- intInt32MapValue
 type intInt32MapValue struct {
 	value *map[int]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intInt32MapValue)(nil)
@@ -8633,10 +9276,12 @@ func newIntInt32MapValue(m *map[int]int32) *intInt32MapValue {
 	}
 }
 
+func (v *intInt32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intInt32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8685,6 +9330,7 @@ func (v *intInt32MapValue) IsCumulative() bool {
 // -- int8Int32MapValue
 type int8Int32MapValue struct {
 	value *map[int8]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Int32MapValue)(nil)
@@ -8697,10 +9343,12 @@ func newInt8Int32MapValue(m *map[int8]int32) *int8Int32MapValue {
 	}
 }
 
+func (v *int8Int32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Int32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8749,6 +9397,7 @@ func (v *int8Int32MapValue) IsCumulative() bool {
 // -- int16Int32MapValue
 type int16Int32MapValue struct {
 	value *map[int16]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Int32MapValue)(nil)
@@ -8761,10 +9410,12 @@ func newInt16Int32MapValue(m *map[int16]int32) *int16Int32MapValue {
 	}
 }
 
+func (v *int16Int32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Int32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8813,6 +9464,7 @@ func (v *int16Int32MapValue) IsCumulative() bool {
 // -- int32Int32MapValue
 type int32Int32MapValue struct {
 	value *map[int32]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Int32MapValue)(nil)
@@ -8825,10 +9477,12 @@ func newInt32Int32MapValue(m *map[int32]int32) *int32Int32MapValue {
 	}
 }
 
+func (v *int32Int32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Int32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8877,6 +9531,7 @@ func (v *int32Int32MapValue) IsCumulative() bool {
 // -- int64Int32MapValue
 type int64Int32MapValue struct {
 	value *map[int64]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Int32MapValue)(nil)
@@ -8889,10 +9544,12 @@ func newInt64Int32MapValue(m *map[int64]int32) *int64Int32MapValue {
 	}
 }
 
+func (v *int64Int32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Int32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -8941,6 +9598,7 @@ func (v *int64Int32MapValue) IsCumulative() bool {
 // -- uintInt32MapValue
 type uintInt32MapValue struct {
 	value *map[uint]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintInt32MapValue)(nil)
@@ -8953,10 +9611,12 @@ func newUintInt32MapValue(m *map[uint]int32) *uintInt32MapValue {
 	}
 }
 
+func (v *uintInt32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintInt32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9005,6 +9665,7 @@ func (v *uintInt32MapValue) IsCumulative() bool {
 // -- uint8Int32MapValue
 type uint8Int32MapValue struct {
 	value *map[uint8]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Int32MapValue)(nil)
@@ -9017,10 +9678,12 @@ func newUint8Int32MapValue(m *map[uint8]int32) *uint8Int32MapValue {
 	}
 }
 
+func (v *uint8Int32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Int32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9069,6 +9732,7 @@ func (v *uint8Int32MapValue) IsCumulative() bool {
 // -- uint16Int32MapValue
 type uint16Int32MapValue struct {
 	value *map[uint16]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Int32MapValue)(nil)
@@ -9081,10 +9745,12 @@ func newUint16Int32MapValue(m *map[uint16]int32) *uint16Int32MapValue {
 	}
 }
 
+func (v *uint16Int32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Int32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9133,6 +9799,7 @@ func (v *uint16Int32MapValue) IsCumulative() bool {
 // -- uint32Int32MapValue
 type uint32Int32MapValue struct {
 	value *map[uint32]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Int32MapValue)(nil)
@@ -9145,10 +9812,12 @@ func newUint32Int32MapValue(m *map[uint32]int32) *uint32Int32MapValue {
 	}
 }
 
+func (v *uint32Int32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Int32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9197,6 +9866,7 @@ func (v *uint32Int32MapValue) IsCumulative() bool {
 // -- uint64Int32MapValue
 type uint64Int32MapValue struct {
 	value *map[uint64]int32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Int32MapValue)(nil)
@@ -9209,10 +9879,12 @@ func newUint64Int32MapValue(m *map[uint64]int32) *uint64Int32MapValue {
 	}
 }
 
+func (v *uint64Int32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Int32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9300,6 +9972,7 @@ func (v *int64Value) Type() string { return "int64" }
 type int64SliceValue struct {
 	value   *[]int64
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*int64SliceValue)(nil)
@@ -9312,8 +9985,13 @@ func newInt64SliceValue(slice *[]int64) *int64SliceValue {
 	}
 }
 
+func (v *int64SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]int64, len(ss))
 	for i, s := range ss {
@@ -9360,6 +10038,7 @@ func (v *int64SliceValue) IsCumulative() bool {
 // -- stringInt64MapValue
 type stringInt64MapValue struct {
 	value *map[string]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringInt64MapValue)(nil)
@@ -9372,10 +10051,12 @@ func newStringInt64MapValue(m *map[string]int64) *stringInt64MapValue {
 	}
 }
 
+func (v *stringInt64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringInt64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9419,6 +10100,7 @@ func (v *stringInt64MapValue) IsCumulative() bool {
 // -- intInt64MapValue
 type intInt64MapValue struct {
 	value *map[int]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intInt64MapValue)(nil)
@@ -9431,10 +10113,12 @@ func newIntInt64MapValue(m *map[int]int64) *intInt64MapValue {
 	}
 }
 
+func (v *intInt64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intInt64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9483,6 +10167,7 @@ func (v *intInt64MapValue) IsCumulative() bool {
 // -- int8Int64MapValue
 type int8Int64MapValue struct {
 	value *map[int8]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Int64MapValue)(nil)
@@ -9495,10 +10180,12 @@ func newInt8Int64MapValue(m *map[int8]int64) *int8Int64MapValue {
 	}
 }
 
+func (v *int8Int64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Int64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9547,6 +10234,7 @@ func (v *int8Int64MapValue) IsCumulative() bool {
 // -- int16Int64MapValue
 type int16Int64MapValue struct {
 	value *map[int16]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Int64MapValue)(nil)
@@ -9559,10 +10247,12 @@ func newInt16Int64MapValue(m *map[int16]int64) *int16Int64MapValue {
 	}
 }
 
+func (v *int16Int64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Int64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9611,6 +10301,7 @@ func (v *int16Int64MapValue) IsCumulative() bool {
 // -- int32Int64MapValue
 type int32Int64MapValue struct {
 	value *map[int32]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Int64MapValue)(nil)
@@ -9623,10 +10314,12 @@ func newInt32Int64MapValue(m *map[int32]int64) *int32Int64MapValue {
 	}
 }
 
+func (v *int32Int64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Int64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9675,6 +10368,7 @@ func (v *int32Int64MapValue) IsCumulative() bool {
 // -- int64Int64MapValue
 type int64Int64MapValue struct {
 	value *map[int64]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Int64MapValue)(nil)
@@ -9687,10 +10381,12 @@ func newInt64Int64MapValue(m *map[int64]int64) *int64Int64MapValue {
 	}
 }
 
+func (v *int64Int64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Int64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9739,6 +10435,7 @@ func (v *int64Int64MapValue) IsCumulative() bool {
 // -- uintInt64MapValue
 type uintInt64MapValue struct {
 	value *map[uint]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintInt64MapValue)(nil)
@@ -9751,10 +10448,12 @@ func newUintInt64MapValue(m *map[uint]int64) *uintInt64MapValue {
 	}
 }
 
+func (v *uintInt64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintInt64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9803,6 +10502,7 @@ func (v *uintInt64MapValue) IsCumulative() bool {
 // -- uint8Int64MapValue
 type uint8Int64MapValue struct {
 	value *map[uint8]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Int64MapValue)(nil)
@@ -9815,10 +10515,12 @@ func newUint8Int64MapValue(m *map[uint8]int64) *uint8Int64MapValue {
 	}
 }
 
+func (v *uint8Int64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Int64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9867,6 +10569,7 @@ func (v *uint8Int64MapValue) IsCumulative() bool {
 // -- uint16Int64MapValue
 type uint16Int64MapValue struct {
 	value *map[uint16]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Int64MapValue)(nil)
@@ -9879,10 +10582,12 @@ func newUint16Int64MapValue(m *map[uint16]int64) *uint16Int64MapValue {
 	}
 }
 
+func (v *uint16Int64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Int64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9931,6 +10636,7 @@ func (v *uint16Int64MapValue) IsCumulative() bool {
 // -- uint32Int64MapValue
 type uint32Int64MapValue struct {
 	value *map[uint32]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Int64MapValue)(nil)
@@ -9943,10 +10649,12 @@ func newUint32Int64MapValue(m *map[uint32]int64) *uint32Int64MapValue {
 	}
 }
 
+func (v *uint32Int64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Int64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -9995,6 +10703,7 @@ func (v *uint32Int64MapValue) IsCumulative() bool {
 // -- uint64Int64MapValue
 type uint64Int64MapValue struct {
 	value *map[uint64]int64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Int64MapValue)(nil)
@@ -10007,10 +10716,12 @@ func newUint64Int64MapValue(m *map[uint64]int64) *uint64Int64MapValue {
 	}
 }
 
+func (v *uint64Int64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Int64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10098,6 +10809,7 @@ func (v *float64Value) Type() string { return "float64" }
 type float64SliceValue struct {
 	value   *[]float64
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*float64SliceValue)(nil)
@@ -10110,8 +10822,13 @@ func newFloat64SliceValue(slice *[]float64) *float64SliceValue {
 	}
 }
 
+func (v *float64SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *float64SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]float64, len(ss))
 	for i, s := range ss {
@@ -10158,6 +10875,7 @@ func (v *float64SliceValue) IsCumulative() bool {
 // -- stringFloat64MapValue
 type stringFloat64MapValue struct {
 	value *map[string]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringFloat64MapValue)(nil)
@@ -10170,10 +10888,12 @@ func newStringFloat64MapValue(m *map[string]float64) *stringFloat64MapValue {
 	}
 }
 
+func (v *stringFloat64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringFloat64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10217,6 +10937,7 @@ func (v *stringFloat64MapValue) IsCumulative() bool {
 // -- intFloat64MapValue
 type intFloat64MapValue struct {
 	value *map[int]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intFloat64MapValue)(nil)
@@ -10229,10 +10950,12 @@ func newIntFloat64MapValue(m *map[int]float64) *intFloat64MapValue {
 	}
 }
 
+func (v *intFloat64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intFloat64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10281,6 +11004,7 @@ func (v *intFloat64MapValue) IsCumulative() bool {
 // -- int8Float64MapValue
 type int8Float64MapValue struct {
 	value *map[int8]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Float64MapValue)(nil)
@@ -10293,10 +11017,12 @@ func newInt8Float64MapValue(m *map[int8]float64) *int8Float64MapValue {
 	}
 }
 
+func (v *int8Float64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Float64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10345,6 +11071,7 @@ func (v *int8Float64MapValue) IsCumulative() bool {
 // -- int16Float64MapValue
 type int16Float64MapValue struct {
 	value *map[int16]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Float64MapValue)(nil)
@@ -10357,10 +11084,12 @@ func newInt16Float64MapValue(m *map[int16]float64) *int16Float64MapValue {
 	}
 }
 
+func (v *int16Float64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Float64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10409,6 +11138,7 @@ func (v *int16Float64MapValue) IsCumulative() bool {
 // -- int32Float64MapValue
 type int32Float64MapValue struct {
 	value *map[int32]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Float64MapValue)(nil)
@@ -10421,10 +11151,12 @@ func newInt32Float64MapValue(m *map[int32]float64) *int32Float64MapValue {
 	}
 }
 
+func (v *int32Float64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Float64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10473,6 +11205,7 @@ func (v *int32Float64MapValue) IsCumulative() bool {
 // -- int64Float64MapValue
 type int64Float64MapValue struct {
 	value *map[int64]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Float64MapValue)(nil)
@@ -10485,10 +11218,12 @@ func newInt64Float64MapValue(m *map[int64]float64) *int64Float64MapValue {
 	}
 }
 
+func (v *int64Float64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Float64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10537,6 +11272,7 @@ func (v *int64Float64MapValue) IsCumulative() bool {
 // -- uintFloat64MapValue
 type uintFloat64MapValue struct {
 	value *map[uint]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintFloat64MapValue)(nil)
@@ -10549,10 +11285,12 @@ func newUintFloat64MapValue(m *map[uint]float64) *uintFloat64MapValue {
 	}
 }
 
+func (v *uintFloat64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintFloat64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10601,6 +11339,7 @@ func (v *uintFloat64MapValue) IsCumulative() bool {
 // -- uint8Float64MapValue
 type uint8Float64MapValue struct {
 	value *map[uint8]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Float64MapValue)(nil)
@@ -10613,10 +11352,12 @@ func newUint8Float64MapValue(m *map[uint8]float64) *uint8Float64MapValue {
 	}
 }
 
+func (v *uint8Float64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Float64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10665,6 +11406,7 @@ func (v *uint8Float64MapValue) IsCumulative() bool {
 // -- uint16Float64MapValue
 type uint16Float64MapValue struct {
 	value *map[uint16]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Float64MapValue)(nil)
@@ -10677,10 +11419,12 @@ func newUint16Float64MapValue(m *map[uint16]float64) *uint16Float64MapValue {
 	}
 }
 
+func (v *uint16Float64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Float64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10729,6 +11473,7 @@ func (v *uint16Float64MapValue) IsCumulative() bool {
 // -- uint32Float64MapValue
 type uint32Float64MapValue struct {
 	value *map[uint32]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Float64MapValue)(nil)
@@ -10741,10 +11486,12 @@ func newUint32Float64MapValue(m *map[uint32]float64) *uint32Float64MapValue {
 	}
 }
 
+func (v *uint32Float64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Float64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10793,6 +11540,7 @@ func (v *uint32Float64MapValue) IsCumulative() bool {
 // -- uint64Float64MapValue
 type uint64Float64MapValue struct {
 	value *map[uint64]float64
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Float64MapValue)(nil)
@@ -10805,10 +11553,12 @@ func newUint64Float64MapValue(m *map[uint64]float64) *uint64Float64MapValue {
 	}
 }
 
+func (v *uint64Float64MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Float64MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -10896,6 +11646,7 @@ func (v *float32Value) Type() string { return "float32" }
 type float32SliceValue struct {
 	value   *[]float32
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*float32SliceValue)(nil)
@@ -10908,8 +11659,13 @@ func newFloat32SliceValue(slice *[]float32) *float32SliceValue {
 	}
 }
 
+func (v *float32SliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *float32SliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]float32, len(ss))
 	for i, s := range ss {
@@ -10956,6 +11712,7 @@ func (v *float32SliceValue) IsCumulative() bool {
 // -- stringFloat32MapValue
 type stringFloat32MapValue struct {
 	value *map[string]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringFloat32MapValue)(nil)
@@ -10968,10 +11725,12 @@ func newStringFloat32MapValue(m *map[string]float32) *stringFloat32MapValue {
 	}
 }
 
+func (v *stringFloat32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringFloat32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11015,6 +11774,7 @@ func (v *stringFloat32MapValue) IsCumulative() bool {
 // -- intFloat32MapValue
 type intFloat32MapValue struct {
 	value *map[int]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intFloat32MapValue)(nil)
@@ -11027,10 +11787,12 @@ func newIntFloat32MapValue(m *map[int]float32) *intFloat32MapValue {
 	}
 }
 
+func (v *intFloat32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intFloat32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11079,6 +11841,7 @@ func (v *intFloat32MapValue) IsCumulative() bool {
 // -- int8Float32MapValue
 type int8Float32MapValue struct {
 	value *map[int8]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8Float32MapValue)(nil)
@@ -11091,10 +11854,12 @@ func newInt8Float32MapValue(m *map[int8]float32) *int8Float32MapValue {
 	}
 }
 
+func (v *int8Float32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8Float32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11143,6 +11908,7 @@ func (v *int8Float32MapValue) IsCumulative() bool {
 // -- int16Float32MapValue
 type int16Float32MapValue struct {
 	value *map[int16]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16Float32MapValue)(nil)
@@ -11155,10 +11921,12 @@ func newInt16Float32MapValue(m *map[int16]float32) *int16Float32MapValue {
 	}
 }
 
+func (v *int16Float32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16Float32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11207,6 +11975,7 @@ func (v *int16Float32MapValue) IsCumulative() bool {
 // -- int32Float32MapValue
 type int32Float32MapValue struct {
 	value *map[int32]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32Float32MapValue)(nil)
@@ -11219,10 +11988,12 @@ func newInt32Float32MapValue(m *map[int32]float32) *int32Float32MapValue {
 	}
 }
 
+func (v *int32Float32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32Float32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11271,6 +12042,7 @@ func (v *int32Float32MapValue) IsCumulative() bool {
 // -- int64Float32MapValue
 type int64Float32MapValue struct {
 	value *map[int64]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64Float32MapValue)(nil)
@@ -11283,10 +12055,12 @@ func newInt64Float32MapValue(m *map[int64]float32) *int64Float32MapValue {
 	}
 }
 
+func (v *int64Float32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64Float32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11335,6 +12109,7 @@ func (v *int64Float32MapValue) IsCumulative() bool {
 // -- uintFloat32MapValue
 type uintFloat32MapValue struct {
 	value *map[uint]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintFloat32MapValue)(nil)
@@ -11347,10 +12122,12 @@ func newUintFloat32MapValue(m *map[uint]float32) *uintFloat32MapValue {
 	}
 }
 
+func (v *uintFloat32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintFloat32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11399,6 +12176,7 @@ func (v *uintFloat32MapValue) IsCumulative() bool {
 // -- uint8Float32MapValue
 type uint8Float32MapValue struct {
 	value *map[uint8]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8Float32MapValue)(nil)
@@ -11411,10 +12189,12 @@ func newUint8Float32MapValue(m *map[uint8]float32) *uint8Float32MapValue {
 	}
 }
 
+func (v *uint8Float32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8Float32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11463,6 +12243,7 @@ func (v *uint8Float32MapValue) IsCumulative() bool {
 // -- uint16Float32MapValue
 type uint16Float32MapValue struct {
 	value *map[uint16]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16Float32MapValue)(nil)
@@ -11475,10 +12256,12 @@ func newUint16Float32MapValue(m *map[uint16]float32) *uint16Float32MapValue {
 	}
 }
 
+func (v *uint16Float32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16Float32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11527,6 +12310,7 @@ func (v *uint16Float32MapValue) IsCumulative() bool {
 // -- uint32Float32MapValue
 type uint32Float32MapValue struct {
 	value *map[uint32]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32Float32MapValue)(nil)
@@ -11539,10 +12323,12 @@ func newUint32Float32MapValue(m *map[uint32]float32) *uint32Float32MapValue {
 	}
 }
 
+func (v *uint32Float32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32Float32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11591,6 +12377,7 @@ func (v *uint32Float32MapValue) IsCumulative() bool {
 // -- uint64Float32MapValue
 type uint64Float32MapValue struct {
 	value *map[uint64]float32
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64Float32MapValue)(nil)
@@ -11603,10 +12390,12 @@ func newUint64Float32MapValue(m *map[uint64]float32) *uint64Float32MapValue {
 	}
 }
 
+func (v *uint64Float32MapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64Float32MapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11694,6 +12483,7 @@ func (v *durationValue) Type() string { return "duration" }
 type durationSliceValue struct {
 	value   *[]time.Duration
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*durationSliceValue)(nil)
@@ -11706,8 +12496,13 @@ func newDurationSliceValue(slice *[]time.Duration) *durationSliceValue {
 	}
 }
 
+func (v *durationSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *durationSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]time.Duration, len(ss))
 	for i, s := range ss {
@@ -11754,6 +12549,7 @@ func (v *durationSliceValue) IsCumulative() bool {
 // -- stringDurationMapValue
 type stringDurationMapValue struct {
 	value *map[string]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringDurationMapValue)(nil)
@@ -11766,10 +12562,12 @@ func newStringDurationMapValue(m *map[string]time.Duration) *stringDurationMapVa
 	}
 }
 
+func (v *stringDurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringDurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11813,6 +12611,7 @@ func (v *stringDurationMapValue) IsCumulative() bool {
 // -- intDurationMapValue
 type intDurationMapValue struct {
 	value *map[int]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intDurationMapValue)(nil)
@@ -11825,10 +12624,12 @@ func newIntDurationMapValue(m *map[int]time.Duration) *intDurationMapValue {
 	}
 }
 
+func (v *intDurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intDurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11877,6 +12678,7 @@ func (v *intDurationMapValue) IsCumulative() bool {
 // -- int8DurationMapValue
 type int8DurationMapValue struct {
 	value *map[int8]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8DurationMapValue)(nil)
@@ -11889,10 +12691,12 @@ func newInt8DurationMapValue(m *map[int8]time.Duration) *int8DurationMapValue {
 	}
 }
 
+func (v *int8DurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8DurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -11941,6 +12745,7 @@ func (v *int8DurationMapValue) IsCumulative() bool {
 // -- int16DurationMapValue
 type int16DurationMapValue struct {
 	value *map[int16]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16DurationMapValue)(nil)
@@ -11953,10 +12758,12 @@ func newInt16DurationMapValue(m *map[int16]time.Duration) *int16DurationMapValue
 	}
 }
 
+func (v *int16DurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16DurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12005,6 +12812,7 @@ func (v *int16DurationMapValue) IsCumulative() bool {
 // -- int32DurationMapValue
 type int32DurationMapValue struct {
 	value *map[int32]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32DurationMapValue)(nil)
@@ -12017,10 +12825,12 @@ func newInt32DurationMapValue(m *map[int32]time.Duration) *int32DurationMapValue
 	}
 }
 
+func (v *int32DurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32DurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12069,6 +12879,7 @@ func (v *int32DurationMapValue) IsCumulative() bool {
 // -- int64DurationMapValue
 type int64DurationMapValue struct {
 	value *map[int64]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64DurationMapValue)(nil)
@@ -12081,10 +12892,12 @@ func newInt64DurationMapValue(m *map[int64]time.Duration) *int64DurationMapValue
 	}
 }
 
+func (v *int64DurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64DurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12133,6 +12946,7 @@ func (v *int64DurationMapValue) IsCumulative() bool {
 // -- uintDurationMapValue
 type uintDurationMapValue struct {
 	value *map[uint]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintDurationMapValue)(nil)
@@ -12145,10 +12959,12 @@ func newUintDurationMapValue(m *map[uint]time.Duration) *uintDurationMapValue {
 	}
 }
 
+func (v *uintDurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintDurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12197,6 +13013,7 @@ func (v *uintDurationMapValue) IsCumulative() bool {
 // -- uint8DurationMapValue
 type uint8DurationMapValue struct {
 	value *map[uint8]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8DurationMapValue)(nil)
@@ -12209,10 +13026,12 @@ func newUint8DurationMapValue(m *map[uint8]time.Duration) *uint8DurationMapValue
 	}
 }
 
+func (v *uint8DurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8DurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12261,6 +13080,7 @@ func (v *uint8DurationMapValue) IsCumulative() bool {
 // -- uint16DurationMapValue
 type uint16DurationMapValue struct {
 	value *map[uint16]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16DurationMapValue)(nil)
@@ -12273,10 +13093,12 @@ func newUint16DurationMapValue(m *map[uint16]time.Duration) *uint16DurationMapVa
 	}
 }
 
+func (v *uint16DurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16DurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12325,6 +13147,7 @@ func (v *uint16DurationMapValue) IsCumulative() bool {
 // -- uint32DurationMapValue
 type uint32DurationMapValue struct {
 	value *map[uint32]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32DurationMapValue)(nil)
@@ -12337,10 +13160,12 @@ func newUint32DurationMapValue(m *map[uint32]time.Duration) *uint32DurationMapVa
 	}
 }
 
+func (v *uint32DurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32DurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12389,6 +13214,7 @@ func (v *uint32DurationMapValue) IsCumulative() bool {
 // -- uint64DurationMapValue
 type uint64DurationMapValue struct {
 	value *map[uint64]time.Duration
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64DurationMapValue)(nil)
@@ -12401,10 +13227,12 @@ func newUint64DurationMapValue(m *map[uint64]time.Duration) *uint64DurationMapVa
 	}
 }
 
+func (v *uint64DurationMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64DurationMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12492,6 +13320,7 @@ func (v *ipValue) Type() string { return "ip" }
 type ipSliceValue struct {
 	value   *[]net.IP
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*ipSliceValue)(nil)
@@ -12504,8 +13333,13 @@ func newIPSliceValue(slice *[]net.IP) *ipSliceValue {
 	}
 }
 
+func (v *ipSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *ipSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]net.IP, len(ss))
 	for i, s := range ss {
@@ -12552,6 +13386,7 @@ func (v *ipSliceValue) IsCumulative() bool {
 // -- stringIPMapValue
 type stringIPMapValue struct {
 	value *map[string]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringIPMapValue)(nil)
@@ -12564,10 +13399,12 @@ func newStringIPMapValue(m *map[string]net.IP) *stringIPMapValue {
 	}
 }
 
+func (v *stringIPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringIPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12611,6 +13448,7 @@ func (v *stringIPMapValue) IsCumulative() bool {
 // -- intIPMapValue
 type intIPMapValue struct {
 	value *map[int]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intIPMapValue)(nil)
@@ -12623,10 +13461,12 @@ func newIntIPMapValue(m *map[int]net.IP) *intIPMapValue {
 	}
 }
 
+func (v *intIPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intIPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12675,6 +13515,7 @@ func (v *intIPMapValue) IsCumulative() bool {
 // -- int8IPMapValue
 type int8IPMapValue struct {
 	value *map[int8]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8IPMapValue)(nil)
@@ -12687,10 +13528,12 @@ func newInt8IPMapValue(m *map[int8]net.IP) *int8IPMapValue {
 	}
 }
 
+func (v *int8IPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8IPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12739,6 +13582,7 @@ func (v *int8IPMapValue) IsCumulative() bool {
 // -- int16IPMapValue
 type int16IPMapValue struct {
 	value *map[int16]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16IPMapValue)(nil)
@@ -12751,10 +13595,12 @@ func newInt16IPMapValue(m *map[int16]net.IP) *int16IPMapValue {
 	}
 }
 
+func (v *int16IPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16IPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12803,6 +13649,7 @@ func (v *int16IPMapValue) IsCumulative() bool {
 // -- int32IPMapValue
 type int32IPMapValue struct {
 	value *map[int32]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32IPMapValue)(nil)
@@ -12815,10 +13662,12 @@ func newInt32IPMapValue(m *map[int32]net.IP) *int32IPMapValue {
 	}
 }
 
+func (v *int32IPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32IPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12867,6 +13716,7 @@ func (v *int32IPMapValue) IsCumulative() bool {
 // -- int64IPMapValue
 type int64IPMapValue struct {
 	value *map[int64]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64IPMapValue)(nil)
@@ -12879,10 +13729,12 @@ func newInt64IPMapValue(m *map[int64]net.IP) *int64IPMapValue {
 	}
 }
 
+func (v *int64IPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64IPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12931,6 +13783,7 @@ func (v *int64IPMapValue) IsCumulative() bool {
 // -- uintIPMapValue
 type uintIPMapValue struct {
 	value *map[uint]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintIPMapValue)(nil)
@@ -12943,10 +13796,12 @@ func newUintIPMapValue(m *map[uint]net.IP) *uintIPMapValue {
 	}
 }
 
+func (v *uintIPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintIPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -12995,6 +13850,7 @@ func (v *uintIPMapValue) IsCumulative() bool {
 // -- uint8IPMapValue
 type uint8IPMapValue struct {
 	value *map[uint8]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8IPMapValue)(nil)
@@ -13007,10 +13863,12 @@ func newUint8IPMapValue(m *map[uint8]net.IP) *uint8IPMapValue {
 	}
 }
 
+func (v *uint8IPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8IPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13059,6 +13917,7 @@ func (v *uint8IPMapValue) IsCumulative() bool {
 // -- uint16IPMapValue
 type uint16IPMapValue struct {
 	value *map[uint16]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16IPMapValue)(nil)
@@ -13071,10 +13930,12 @@ func newUint16IPMapValue(m *map[uint16]net.IP) *uint16IPMapValue {
 	}
 }
 
+func (v *uint16IPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16IPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13123,6 +13984,7 @@ func (v *uint16IPMapValue) IsCumulative() bool {
 // -- uint32IPMapValue
 type uint32IPMapValue struct {
 	value *map[uint32]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32IPMapValue)(nil)
@@ -13135,10 +13997,12 @@ func newUint32IPMapValue(m *map[uint32]net.IP) *uint32IPMapValue {
 	}
 }
 
+func (v *uint32IPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32IPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13187,6 +14051,7 @@ func (v *uint32IPMapValue) IsCumulative() bool {
 // -- uint64IPMapValue
 type uint64IPMapValue struct {
 	value *map[uint64]net.IP
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64IPMapValue)(nil)
@@ -13199,10 +14064,12 @@ func newUint64IPMapValue(m *map[uint64]net.IP) *uint64IPMapValue {
 	}
 }
 
+func (v *uint64IPMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64IPMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13290,6 +14157,7 @@ func (v *hexBytesValue) Type() string { return "hexBytes" }
 type hexBytesSliceValue struct {
 	value   *[]HexBytes
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*hexBytesSliceValue)(nil)
@@ -13302,8 +14170,13 @@ func newHexBytesSliceValue(slice *[]HexBytes) *hexBytesSliceValue {
 	}
 }
 
+func (v *hexBytesSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *hexBytesSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]HexBytes, len(ss))
 	for i, s := range ss {
@@ -13350,6 +14223,7 @@ func (v *hexBytesSliceValue) IsCumulative() bool {
 // -- stringHexBytesMapValue
 type stringHexBytesMapValue struct {
 	value *map[string]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringHexBytesMapValue)(nil)
@@ -13362,10 +14236,12 @@ func newStringHexBytesMapValue(m *map[string]HexBytes) *stringHexBytesMapValue {
 	}
 }
 
+func (v *stringHexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringHexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13409,6 +14285,7 @@ func (v *stringHexBytesMapValue) IsCumulative() bool {
 // -- intHexBytesMapValue
 type intHexBytesMapValue struct {
 	value *map[int]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intHexBytesMapValue)(nil)
@@ -13421,10 +14298,12 @@ func newIntHexBytesMapValue(m *map[int]HexBytes) *intHexBytesMapValue {
 	}
 }
 
+func (v *intHexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intHexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13473,6 +14352,7 @@ func (v *intHexBytesMapValue) IsCumulative() bool {
 // -- int8HexBytesMapValue
 type int8HexBytesMapValue struct {
 	value *map[int8]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8HexBytesMapValue)(nil)
@@ -13485,10 +14365,12 @@ func newInt8HexBytesMapValue(m *map[int8]HexBytes) *int8HexBytesMapValue {
 	}
 }
 
+func (v *int8HexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8HexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13537,6 +14419,7 @@ func (v *int8HexBytesMapValue) IsCumulative() bool {
 // -- int16HexBytesMapValue
 type int16HexBytesMapValue struct {
 	value *map[int16]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16HexBytesMapValue)(nil)
@@ -13549,10 +14432,12 @@ func newInt16HexBytesMapValue(m *map[int16]HexBytes) *int16HexBytesMapValue {
 	}
 }
 
+func (v *int16HexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16HexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13601,6 +14486,7 @@ func (v *int16HexBytesMapValue) IsCumulative() bool {
 // -- int32HexBytesMapValue
 type int32HexBytesMapValue struct {
 	value *map[int32]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32HexBytesMapValue)(nil)
@@ -13613,10 +14499,12 @@ func newInt32HexBytesMapValue(m *map[int32]HexBytes) *int32HexBytesMapValue {
 	}
 }
 
+func (v *int32HexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32HexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13665,6 +14553,7 @@ func (v *int32HexBytesMapValue) IsCumulative() bool {
 // -- int64HexBytesMapValue
 type int64HexBytesMapValue struct {
 	value *map[int64]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64HexBytesMapValue)(nil)
@@ -13677,10 +14566,12 @@ func newInt64HexBytesMapValue(m *map[int64]HexBytes) *int64HexBytesMapValue {
 	}
 }
 
+func (v *int64HexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64HexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13729,6 +14620,7 @@ func (v *int64HexBytesMapValue) IsCumulative() bool {
 // -- uintHexBytesMapValue
 type uintHexBytesMapValue struct {
 	value *map[uint]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintHexBytesMapValue)(nil)
@@ -13741,10 +14633,12 @@ func newUintHexBytesMapValue(m *map[uint]HexBytes) *uintHexBytesMapValue {
 	}
 }
 
+func (v *uintHexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintHexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13793,6 +14687,7 @@ func (v *uintHexBytesMapValue) IsCumulative() bool {
 // -- uint8HexBytesMapValue
 type uint8HexBytesMapValue struct {
 	value *map[uint8]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8HexBytesMapValue)(nil)
@@ -13805,10 +14700,12 @@ func newUint8HexBytesMapValue(m *map[uint8]HexBytes) *uint8HexBytesMapValue {
 	}
 }
 
+func (v *uint8HexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8HexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13857,6 +14754,7 @@ func (v *uint8HexBytesMapValue) IsCumulative() bool {
 // -- uint16HexBytesMapValue
 type uint16HexBytesMapValue struct {
 	value *map[uint16]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16HexBytesMapValue)(nil)
@@ -13869,10 +14767,12 @@ func newUint16HexBytesMapValue(m *map[uint16]HexBytes) *uint16HexBytesMapValue {
 	}
 }
 
+func (v *uint16HexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16HexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13921,6 +14821,7 @@ func (v *uint16HexBytesMapValue) IsCumulative() bool {
 // -- uint32HexBytesMapValue
 type uint32HexBytesMapValue struct {
 	value *map[uint32]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32HexBytesMapValue)(nil)
@@ -13933,10 +14834,12 @@ func newUint32HexBytesMapValue(m *map[uint32]HexBytes) *uint32HexBytesMapValue {
 	}
 }
 
+func (v *uint32HexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32HexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -13985,6 +14888,7 @@ func (v *uint32HexBytesMapValue) IsCumulative() bool {
 // -- uint64HexBytesMapValue
 type uint64HexBytesMapValue struct {
 	value *map[uint64]HexBytes
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64HexBytesMapValue)(nil)
@@ -13997,10 +14901,12 @@ func newUint64HexBytesMapValue(m *map[uint64]HexBytes) *uint64HexBytesMapValue {
 	}
 }
 
+func (v *uint64HexBytesMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64HexBytesMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14088,6 +14994,7 @@ func (v *regexpValue) Type() string { return "regexp" }
 type regexpSliceValue struct {
 	value   *[]*regexp.Regexp
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*regexpSliceValue)(nil)
@@ -14100,8 +15007,13 @@ func newRegexpSliceValue(slice *[]*regexp.Regexp) *regexpSliceValue {
 	}
 }
 
+func (v *regexpSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *regexpSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]*regexp.Regexp, len(ss))
 	for i, s := range ss {
@@ -14148,6 +15060,7 @@ func (v *regexpSliceValue) IsCumulative() bool {
 // -- stringRegexpMapValue
 type stringRegexpMapValue struct {
 	value *map[string]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringRegexpMapValue)(nil)
@@ -14160,10 +15073,12 @@ func newStringRegexpMapValue(m *map[string]*regexp.Regexp) *stringRegexpMapValue
 	}
 }
 
+func (v *stringRegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringRegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14207,6 +15122,7 @@ func (v *stringRegexpMapValue) IsCumulative() bool {
 // -- intRegexpMapValue
 type intRegexpMapValue struct {
 	value *map[int]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intRegexpMapValue)(nil)
@@ -14219,10 +15135,12 @@ func newIntRegexpMapValue(m *map[int]*regexp.Regexp) *intRegexpMapValue {
 	}
 }
 
+func (v *intRegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intRegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14271,6 +15189,7 @@ func (v *intRegexpMapValue) IsCumulative() bool {
 // -- int8RegexpMapValue
 type int8RegexpMapValue struct {
 	value *map[int8]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8RegexpMapValue)(nil)
@@ -14283,10 +15202,12 @@ func newInt8RegexpMapValue(m *map[int8]*regexp.Regexp) *int8RegexpMapValue {
 	}
 }
 
+func (v *int8RegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8RegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14335,6 +15256,7 @@ func (v *int8RegexpMapValue) IsCumulative() bool {
 // -- int16RegexpMapValue
 type int16RegexpMapValue struct {
 	value *map[int16]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16RegexpMapValue)(nil)
@@ -14347,10 +15269,12 @@ func newInt16RegexpMapValue(m *map[int16]*regexp.Regexp) *int16RegexpMapValue {
 	}
 }
 
+func (v *int16RegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16RegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14399,6 +15323,7 @@ func (v *int16RegexpMapValue) IsCumulative() bool {
 // -- int32RegexpMapValue
 type int32RegexpMapValue struct {
 	value *map[int32]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32RegexpMapValue)(nil)
@@ -14411,10 +15336,12 @@ func newInt32RegexpMapValue(m *map[int32]*regexp.Regexp) *int32RegexpMapValue {
 	}
 }
 
+func (v *int32RegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32RegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14463,6 +15390,7 @@ func (v *int32RegexpMapValue) IsCumulative() bool {
 // -- int64RegexpMapValue
 type int64RegexpMapValue struct {
 	value *map[int64]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64RegexpMapValue)(nil)
@@ -14475,10 +15403,12 @@ func newInt64RegexpMapValue(m *map[int64]*regexp.Regexp) *int64RegexpMapValue {
 	}
 }
 
+func (v *int64RegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64RegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14527,6 +15457,7 @@ func (v *int64RegexpMapValue) IsCumulative() bool {
 // -- uintRegexpMapValue
 type uintRegexpMapValue struct {
 	value *map[uint]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintRegexpMapValue)(nil)
@@ -14539,10 +15470,12 @@ func newUintRegexpMapValue(m *map[uint]*regexp.Regexp) *uintRegexpMapValue {
 	}
 }
 
+func (v *uintRegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintRegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14591,6 +15524,7 @@ func (v *uintRegexpMapValue) IsCumulative() bool {
 // -- uint8RegexpMapValue
 type uint8RegexpMapValue struct {
 	value *map[uint8]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8RegexpMapValue)(nil)
@@ -14603,10 +15537,12 @@ func newUint8RegexpMapValue(m *map[uint8]*regexp.Regexp) *uint8RegexpMapValue {
 	}
 }
 
+func (v *uint8RegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8RegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14655,6 +15591,7 @@ func (v *uint8RegexpMapValue) IsCumulative() bool {
 // -- uint16RegexpMapValue
 type uint16RegexpMapValue struct {
 	value *map[uint16]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16RegexpMapValue)(nil)
@@ -14667,10 +15604,12 @@ func newUint16RegexpMapValue(m *map[uint16]*regexp.Regexp) *uint16RegexpMapValue
 	}
 }
 
+func (v *uint16RegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16RegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14719,6 +15658,7 @@ func (v *uint16RegexpMapValue) IsCumulative() bool {
 // -- uint32RegexpMapValue
 type uint32RegexpMapValue struct {
 	value *map[uint32]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32RegexpMapValue)(nil)
@@ -14731,10 +15671,12 @@ func newUint32RegexpMapValue(m *map[uint32]*regexp.Regexp) *uint32RegexpMapValue
 	}
 }
 
+func (v *uint32RegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32RegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14783,6 +15725,7 @@ func (v *uint32RegexpMapValue) IsCumulative() bool {
 // -- uint64RegexpMapValue
 type uint64RegexpMapValue struct {
 	value *map[uint64]*regexp.Regexp
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64RegexpMapValue)(nil)
@@ -14795,10 +15738,12 @@ func newUint64RegexpMapValue(m *map[uint64]*regexp.Regexp) *uint64RegexpMapValue
 	}
 }
 
+func (v *uint64RegexpMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64RegexpMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -14886,6 +15831,7 @@ func (v *tcpAddrValue) Type() string { return "tcpAddr" }
 type tcpAddrSliceValue struct {
 	value   *[]net.TCPAddr
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*tcpAddrSliceValue)(nil)
@@ -14898,8 +15844,13 @@ func newTCPAddrSliceValue(slice *[]net.TCPAddr) *tcpAddrSliceValue {
 	}
 }
 
+func (v *tcpAddrSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *tcpAddrSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]net.TCPAddr, len(ss))
 	for i, s := range ss {
@@ -14985,6 +15936,7 @@ func (v *ipNetValue) Type() string { return "ipNet" }
 type ipNetSliceValue struct {
 	value   *[]net.IPNet
 	changed bool
+	seps    *separators
 }
 
 var _ RepeatableFlag = (*ipNetSliceValue)(nil)
@@ -14997,8 +15949,13 @@ func newIPNetSliceValue(slice *[]net.IPNet) *ipNetSliceValue {
 	}
 }
 
+func (v *ipNetSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *ipNetSliceValue) Set(raw string) error {
-	ss := strings.Split(raw, ",")
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
 
 	out := make([]net.IPNet, len(ss))
 	for i, s := range ss {
@@ -15045,6 +16002,7 @@ func (v *ipNetSliceValue) IsCumulative() bool {
 // -- stringIPNetMapValue
 type stringIPNetMapValue struct {
 	value *map[string]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*stringIPNetMapValue)(nil)
@@ -15057,10 +16015,12 @@ func newStringIPNetMapValue(m *map[string]net.IPNet) *stringIPNetMapValue {
 	}
 }
 
+func (v *stringIPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *stringIPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15104,6 +16064,7 @@ func (v *stringIPNetMapValue) IsCumulative() bool {
 // -- intIPNetMapValue
 type intIPNetMapValue struct {
 	value *map[int]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*intIPNetMapValue)(nil)
@@ -15116,10 +16077,12 @@ func newIntIPNetMapValue(m *map[int]net.IPNet) *intIPNetMapValue {
 	}
 }
 
+func (v *intIPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *intIPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15168,6 +16131,7 @@ func (v *intIPNetMapValue) IsCumulative() bool {
 // -- int8IPNetMapValue
 type int8IPNetMapValue struct {
 	value *map[int8]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int8IPNetMapValue)(nil)
@@ -15180,10 +16144,12 @@ func newInt8IPNetMapValue(m *map[int8]net.IPNet) *int8IPNetMapValue {
 	}
 }
 
+func (v *int8IPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int8IPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15232,6 +16198,7 @@ func (v *int8IPNetMapValue) IsCumulative() bool {
 // -- int16IPNetMapValue
 type int16IPNetMapValue struct {
 	value *map[int16]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int16IPNetMapValue)(nil)
@@ -15244,10 +16211,12 @@ func newInt16IPNetMapValue(m *map[int16]net.IPNet) *int16IPNetMapValue {
 	}
 }
 
+func (v *int16IPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int16IPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15296,6 +16265,7 @@ func (v *int16IPNetMapValue) IsCumulative() bool {
 // -- int32IPNetMapValue
 type int32IPNetMapValue struct {
 	value *map[int32]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int32IPNetMapValue)(nil)
@@ -15308,10 +16278,12 @@ func newInt32IPNetMapValue(m *map[int32]net.IPNet) *int32IPNetMapValue {
 	}
 }
 
+func (v *int32IPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int32IPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15360,6 +16332,7 @@ func (v *int32IPNetMapValue) IsCumulative() bool {
 // -- int64IPNetMapValue
 type int64IPNetMapValue struct {
 	value *map[int64]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*int64IPNetMapValue)(nil)
@@ -15372,10 +16345,12 @@ func newInt64IPNetMapValue(m *map[int64]net.IPNet) *int64IPNetMapValue {
 	}
 }
 
+func (v *int64IPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *int64IPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15424,6 +16399,7 @@ func (v *int64IPNetMapValue) IsCumulative() bool {
 // -- uintIPNetMapValue
 type uintIPNetMapValue struct {
 	value *map[uint]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uintIPNetMapValue)(nil)
@@ -15436,10 +16412,12 @@ func newUintIPNetMapValue(m *map[uint]net.IPNet) *uintIPNetMapValue {
 	}
 }
 
+func (v *uintIPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uintIPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15488,6 +16466,7 @@ func (v *uintIPNetMapValue) IsCumulative() bool {
 // -- uint8IPNetMapValue
 type uint8IPNetMapValue struct {
 	value *map[uint8]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint8IPNetMapValue)(nil)
@@ -15500,10 +16479,12 @@ func newUint8IPNetMapValue(m *map[uint8]net.IPNet) *uint8IPNetMapValue {
 	}
 }
 
+func (v *uint8IPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint8IPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15552,6 +16533,7 @@ func (v *uint8IPNetMapValue) IsCumulative() bool {
 // -- uint16IPNetMapValue
 type uint16IPNetMapValue struct {
 	value *map[uint16]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint16IPNetMapValue)(nil)
@@ -15564,10 +16546,12 @@ func newUint16IPNetMapValue(m *map[uint16]net.IPNet) *uint16IPNetMapValue {
 	}
 }
 
+func (v *uint16IPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint16IPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15616,6 +16600,7 @@ func (v *uint16IPNetMapValue) IsCumulative() bool {
 // -- uint32IPNetMapValue
 type uint32IPNetMapValue struct {
 	value *map[uint32]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint32IPNetMapValue)(nil)
@@ -15628,10 +16613,12 @@ func newUint32IPNetMapValue(m *map[uint32]net.IPNet) *uint32IPNetMapValue {
 	}
 }
 
+func (v *uint32IPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint32IPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15680,6 +16667,7 @@ func (v *uint32IPNetMapValue) IsCumulative() bool {
 // -- uint64IPNetMapValue
 type uint64IPNetMapValue struct {
 	value *map[uint64]net.IPNet
+	seps  *separators
 }
 
 var _ RepeatableFlag = (*uint64IPNetMapValue)(nil)
@@ -15692,10 +16680,12 @@ func newUint64IPNetMapValue(m *map[uint64]net.IPNet) *uint64IPNetMapValue {
 	}
 }
 
+func (v *uint64IPNetMapValue) setSeparators(seps *separators) { v.seps = seps }
+
 func (v *uint64IPNetMapValue) Set(s string) error {
-	ss := strings.SplitN(s, ":", 2)
-	if len(ss) < 2 {
-		return errors.New("invalid map flag syntax, use -map=key1:val1")
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
 	}
 
 	s = ss[0]
@@ -15740,3 +16730,7544 @@ func (v *uint64IPNetMapValue) Type() string { return "map[uint64]net.IPNet" }
 func (v *uint64IPNetMapValue) IsCumulative() bool {
 	return true
 }
+
+// -- url.URL Value
+type urlValue struct {
+	value *url.URL
+}
+
+var _ Value = (*urlValue)(nil)
+var _ Getter = (*urlValue)(nil)
+
+func newURLValue(p *url.URL) *urlValue {
+	return &urlValue{value: p}
+}
+
+func (v *urlValue) Set(s string) error {
+	parsed, err := parseURL(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *urlValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *urlValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *urlValue) Type() string { return "url" }
+
+// -- url.URLSlice Value
+
+type urlSliceValue struct {
+	value   *[]url.URL
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*urlSliceValue)(nil)
+var _ Value = (*urlSliceValue)(nil)
+var _ Getter = (*urlSliceValue)(nil)
+
+func newURLSliceValue(slice *[]url.URL) *urlSliceValue {
+	return &urlSliceValue{
+		value: slice,
+	}
+}
+
+func (v *urlSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *urlSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]url.URL, len(ss))
+	for i, s := range ss {
+		parsed, err := parseURL(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *urlSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]url.URL)(nil)
+}
+
+func (v *urlSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newURLValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *urlSliceValue) Type() string { return "urlSlice" }
+
+func (v *urlSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- stringURLMapValue
+type stringURLMapValue struct {
+	value *map[string]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*stringURLMapValue)(nil)
+var _ Value = (*stringURLMapValue)(nil)
+var _ Getter = (*stringURLMapValue)(nil)
+
+func newStringURLMapValue(m *map[string]url.URL) *stringURLMapValue {
+	return &stringURLMapValue{
+		value: m,
+	}
+}
+
+func (v *stringURLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *stringURLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	key := s
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringURLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringURLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringURLMapValue) Type() string { return "map[string]url.URL" }
+
+func (v *stringURLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intURLMapValue
+type intURLMapValue struct {
+	value *map[int]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*intURLMapValue)(nil)
+var _ Value = (*intURLMapValue)(nil)
+var _ Getter = (*intURLMapValue)(nil)
+
+func newIntURLMapValue(m *map[int]url.URL) *intURLMapValue {
+	return &intURLMapValue{
+		value: m,
+	}
+}
+
+func (v *intURLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *intURLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intURLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intURLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intURLMapValue) Type() string { return "map[int]url.URL" }
+
+func (v *intURLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8URLMapValue
+type int8URLMapValue struct {
+	value *map[int8]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int8URLMapValue)(nil)
+var _ Value = (*int8URLMapValue)(nil)
+var _ Getter = (*int8URLMapValue)(nil)
+
+func newInt8URLMapValue(m *map[int8]url.URL) *int8URLMapValue {
+	return &int8URLMapValue{
+		value: m,
+	}
+}
+
+func (v *int8URLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int8URLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8URLMapValue) Type() string { return "map[int8]url.URL" }
+
+func (v *int8URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16URLMapValue
+type int16URLMapValue struct {
+	value *map[int16]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int16URLMapValue)(nil)
+var _ Value = (*int16URLMapValue)(nil)
+var _ Getter = (*int16URLMapValue)(nil)
+
+func newInt16URLMapValue(m *map[int16]url.URL) *int16URLMapValue {
+	return &int16URLMapValue{
+		value: m,
+	}
+}
+
+func (v *int16URLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int16URLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16URLMapValue) Type() string { return "map[int16]url.URL" }
+
+func (v *int16URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32URLMapValue
+type int32URLMapValue struct {
+	value *map[int32]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int32URLMapValue)(nil)
+var _ Value = (*int32URLMapValue)(nil)
+var _ Getter = (*int32URLMapValue)(nil)
+
+func newInt32URLMapValue(m *map[int32]url.URL) *int32URLMapValue {
+	return &int32URLMapValue{
+		value: m,
+	}
+}
+
+func (v *int32URLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int32URLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32URLMapValue) Type() string { return "map[int32]url.URL" }
+
+func (v *int32URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64URLMapValue
+type int64URLMapValue struct {
+	value *map[int64]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int64URLMapValue)(nil)
+var _ Value = (*int64URLMapValue)(nil)
+var _ Getter = (*int64URLMapValue)(nil)
+
+func newInt64URLMapValue(m *map[int64]url.URL) *int64URLMapValue {
+	return &int64URLMapValue{
+		value: m,
+	}
+}
+
+func (v *int64URLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int64URLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64URLMapValue) Type() string { return "map[int64]url.URL" }
+
+func (v *int64URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintURLMapValue
+type uintURLMapValue struct {
+	value *map[uint]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uintURLMapValue)(nil)
+var _ Value = (*uintURLMapValue)(nil)
+var _ Getter = (*uintURLMapValue)(nil)
+
+func newUintURLMapValue(m *map[uint]url.URL) *uintURLMapValue {
+	return &uintURLMapValue{
+		value: m,
+	}
+}
+
+func (v *uintURLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uintURLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintURLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintURLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintURLMapValue) Type() string { return "map[uint]url.URL" }
+
+func (v *uintURLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8URLMapValue
+type uint8URLMapValue struct {
+	value *map[uint8]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint8URLMapValue)(nil)
+var _ Value = (*uint8URLMapValue)(nil)
+var _ Getter = (*uint8URLMapValue)(nil)
+
+func newUint8URLMapValue(m *map[uint8]url.URL) *uint8URLMapValue {
+	return &uint8URLMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8URLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint8URLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8URLMapValue) Type() string { return "map[uint8]url.URL" }
+
+func (v *uint8URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16URLMapValue
+type uint16URLMapValue struct {
+	value *map[uint16]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint16URLMapValue)(nil)
+var _ Value = (*uint16URLMapValue)(nil)
+var _ Getter = (*uint16URLMapValue)(nil)
+
+func newUint16URLMapValue(m *map[uint16]url.URL) *uint16URLMapValue {
+	return &uint16URLMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16URLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint16URLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16URLMapValue) Type() string { return "map[uint16]url.URL" }
+
+func (v *uint16URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32URLMapValue
+type uint32URLMapValue struct {
+	value *map[uint32]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint32URLMapValue)(nil)
+var _ Value = (*uint32URLMapValue)(nil)
+var _ Getter = (*uint32URLMapValue)(nil)
+
+func newUint32URLMapValue(m *map[uint32]url.URL) *uint32URLMapValue {
+	return &uint32URLMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32URLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint32URLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32URLMapValue) Type() string { return "map[uint32]url.URL" }
+
+func (v *uint32URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64URLMapValue
+type uint64URLMapValue struct {
+	value *map[uint64]url.URL
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint64URLMapValue)(nil)
+var _ Value = (*uint64URLMapValue)(nil)
+var _ Getter = (*uint64URLMapValue)(nil)
+
+func newUint64URLMapValue(m *map[uint64]url.URL) *uint64URLMapValue {
+	return &uint64URLMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64URLMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint64URLMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := parseURL(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64URLMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64URLMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64URLMapValue) Type() string { return "map[uint64]url.URL" }
+
+func (v *uint64URLMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- ByteSize Value
+type byteSizeValue struct {
+	value *ByteSize
+}
+
+var _ Value = (*byteSizeValue)(nil)
+var _ Getter = (*byteSizeValue)(nil)
+
+func newByteSizeValue(p *ByteSize) *byteSizeValue {
+	return &byteSizeValue{value: p}
+}
+
+func (v *byteSizeValue) Set(s string) error {
+	parsed, err := ParseByteSize(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *byteSizeValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *byteSizeValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *byteSizeValue) Type() string { return "byteSize" }
+
+// -- ByteSizeSlice Value
+
+type byteSizeSliceValue struct {
+	value   *[]ByteSize
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*byteSizeSliceValue)(nil)
+var _ Value = (*byteSizeSliceValue)(nil)
+var _ Getter = (*byteSizeSliceValue)(nil)
+
+func newByteSizeSliceValue(slice *[]ByteSize) *byteSizeSliceValue {
+	return &byteSizeSliceValue{
+		value: slice,
+	}
+}
+
+func (v *byteSizeSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *byteSizeSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]ByteSize, len(ss))
+	for i, s := range ss {
+		parsed, err := ParseByteSize(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *byteSizeSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]ByteSize)(nil)
+}
+
+func (v *byteSizeSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newByteSizeValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *byteSizeSliceValue) Type() string { return "byteSizeSlice" }
+
+func (v *byteSizeSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- stringByteSizeMapValue
+type stringByteSizeMapValue struct {
+	value *map[string]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*stringByteSizeMapValue)(nil)
+var _ Value = (*stringByteSizeMapValue)(nil)
+var _ Getter = (*stringByteSizeMapValue)(nil)
+
+func newStringByteSizeMapValue(m *map[string]ByteSize) *stringByteSizeMapValue {
+	return &stringByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *stringByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *stringByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	key := s
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringByteSizeMapValue) Type() string { return "map[string]ByteSize" }
+
+func (v *stringByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intByteSizeMapValue
+type intByteSizeMapValue struct {
+	value *map[int]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*intByteSizeMapValue)(nil)
+var _ Value = (*intByteSizeMapValue)(nil)
+var _ Getter = (*intByteSizeMapValue)(nil)
+
+func newIntByteSizeMapValue(m *map[int]ByteSize) *intByteSizeMapValue {
+	return &intByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *intByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *intByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intByteSizeMapValue) Type() string { return "map[int]ByteSize" }
+
+func (v *intByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8ByteSizeMapValue
+type int8ByteSizeMapValue struct {
+	value *map[int8]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int8ByteSizeMapValue)(nil)
+var _ Value = (*int8ByteSizeMapValue)(nil)
+var _ Getter = (*int8ByteSizeMapValue)(nil)
+
+func newInt8ByteSizeMapValue(m *map[int8]ByteSize) *int8ByteSizeMapValue {
+	return &int8ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *int8ByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int8ByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8ByteSizeMapValue) Type() string { return "map[int8]ByteSize" }
+
+func (v *int8ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16ByteSizeMapValue
+type int16ByteSizeMapValue struct {
+	value *map[int16]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int16ByteSizeMapValue)(nil)
+var _ Value = (*int16ByteSizeMapValue)(nil)
+var _ Getter = (*int16ByteSizeMapValue)(nil)
+
+func newInt16ByteSizeMapValue(m *map[int16]ByteSize) *int16ByteSizeMapValue {
+	return &int16ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *int16ByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int16ByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16ByteSizeMapValue) Type() string { return "map[int16]ByteSize" }
+
+func (v *int16ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32ByteSizeMapValue
+type int32ByteSizeMapValue struct {
+	value *map[int32]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int32ByteSizeMapValue)(nil)
+var _ Value = (*int32ByteSizeMapValue)(nil)
+var _ Getter = (*int32ByteSizeMapValue)(nil)
+
+func newInt32ByteSizeMapValue(m *map[int32]ByteSize) *int32ByteSizeMapValue {
+	return &int32ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *int32ByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int32ByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32ByteSizeMapValue) Type() string { return "map[int32]ByteSize" }
+
+func (v *int32ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64ByteSizeMapValue
+type int64ByteSizeMapValue struct {
+	value *map[int64]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int64ByteSizeMapValue)(nil)
+var _ Value = (*int64ByteSizeMapValue)(nil)
+var _ Getter = (*int64ByteSizeMapValue)(nil)
+
+func newInt64ByteSizeMapValue(m *map[int64]ByteSize) *int64ByteSizeMapValue {
+	return &int64ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *int64ByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int64ByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64ByteSizeMapValue) Type() string { return "map[int64]ByteSize" }
+
+func (v *int64ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintByteSizeMapValue
+type uintByteSizeMapValue struct {
+	value *map[uint]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uintByteSizeMapValue)(nil)
+var _ Value = (*uintByteSizeMapValue)(nil)
+var _ Getter = (*uintByteSizeMapValue)(nil)
+
+func newUintByteSizeMapValue(m *map[uint]ByteSize) *uintByteSizeMapValue {
+	return &uintByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uintByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uintByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintByteSizeMapValue) Type() string { return "map[uint]ByteSize" }
+
+func (v *uintByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8ByteSizeMapValue
+type uint8ByteSizeMapValue struct {
+	value *map[uint8]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint8ByteSizeMapValue)(nil)
+var _ Value = (*uint8ByteSizeMapValue)(nil)
+var _ Getter = (*uint8ByteSizeMapValue)(nil)
+
+func newUint8ByteSizeMapValue(m *map[uint8]ByteSize) *uint8ByteSizeMapValue {
+	return &uint8ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8ByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint8ByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8ByteSizeMapValue) Type() string { return "map[uint8]ByteSize" }
+
+func (v *uint8ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16ByteSizeMapValue
+type uint16ByteSizeMapValue struct {
+	value *map[uint16]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint16ByteSizeMapValue)(nil)
+var _ Value = (*uint16ByteSizeMapValue)(nil)
+var _ Getter = (*uint16ByteSizeMapValue)(nil)
+
+func newUint16ByteSizeMapValue(m *map[uint16]ByteSize) *uint16ByteSizeMapValue {
+	return &uint16ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16ByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint16ByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16ByteSizeMapValue) Type() string { return "map[uint16]ByteSize" }
+
+func (v *uint16ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32ByteSizeMapValue
+type uint32ByteSizeMapValue struct {
+	value *map[uint32]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint32ByteSizeMapValue)(nil)
+var _ Value = (*uint32ByteSizeMapValue)(nil)
+var _ Getter = (*uint32ByteSizeMapValue)(nil)
+
+func newUint32ByteSizeMapValue(m *map[uint32]ByteSize) *uint32ByteSizeMapValue {
+	return &uint32ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32ByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint32ByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32ByteSizeMapValue) Type() string { return "map[uint32]ByteSize" }
+
+func (v *uint32ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64ByteSizeMapValue
+type uint64ByteSizeMapValue struct {
+	value *map[uint64]ByteSize
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint64ByteSizeMapValue)(nil)
+var _ Value = (*uint64ByteSizeMapValue)(nil)
+var _ Getter = (*uint64ByteSizeMapValue)(nil)
+
+func newUint64ByteSizeMapValue(m *map[uint64]ByteSize) *uint64ByteSizeMapValue {
+	return &uint64ByteSizeMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64ByteSizeMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint64ByteSizeMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64ByteSizeMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64ByteSizeMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64ByteSizeMapValue) Type() string { return "map[uint64]ByteSize" }
+
+func (v *uint64ByteSizeMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- Rate Value
+type rateValue struct {
+	value *Rate
+}
+
+var _ Value = (*rateValue)(nil)
+var _ Getter = (*rateValue)(nil)
+
+func newRateValue(p *Rate) *rateValue {
+	return &rateValue{value: p}
+}
+
+func (v *rateValue) Set(s string) error {
+	parsed, err := ParseRate(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *rateValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *rateValue) String() string {
+	if v != nil && v.value != nil {
+		return v.value.String()
+	}
+	return ""
+}
+
+func (v *rateValue) Type() string { return "rate" }
+
+// -- RateSlice Value
+
+type rateSliceValue struct {
+	value   *[]Rate
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*rateSliceValue)(nil)
+var _ Value = (*rateSliceValue)(nil)
+var _ Getter = (*rateSliceValue)(nil)
+
+func newRateSliceValue(slice *[]Rate) *rateSliceValue {
+	return &rateSliceValue{
+		value: slice,
+	}
+}
+
+func (v *rateSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *rateSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]Rate, len(ss))
+	for i, s := range ss {
+		parsed, err := ParseRate(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *rateSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]Rate)(nil)
+}
+
+func (v *rateSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newRateValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *rateSliceValue) Type() string { return "rateSlice" }
+
+func (v *rateSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- stringRateMapValue
+type stringRateMapValue struct {
+	value *map[string]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*stringRateMapValue)(nil)
+var _ Value = (*stringRateMapValue)(nil)
+var _ Getter = (*stringRateMapValue)(nil)
+
+func newStringRateMapValue(m *map[string]Rate) *stringRateMapValue {
+	return &stringRateMapValue{
+		value: m,
+	}
+}
+
+func (v *stringRateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *stringRateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	key := s
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringRateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringRateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringRateMapValue) Type() string { return "map[string]Rate" }
+
+func (v *stringRateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intRateMapValue
+type intRateMapValue struct {
+	value *map[int]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*intRateMapValue)(nil)
+var _ Value = (*intRateMapValue)(nil)
+var _ Getter = (*intRateMapValue)(nil)
+
+func newIntRateMapValue(m *map[int]Rate) *intRateMapValue {
+	return &intRateMapValue{
+		value: m,
+	}
+}
+
+func (v *intRateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *intRateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intRateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intRateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intRateMapValue) Type() string { return "map[int]Rate" }
+
+func (v *intRateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8RateMapValue
+type int8RateMapValue struct {
+	value *map[int8]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int8RateMapValue)(nil)
+var _ Value = (*int8RateMapValue)(nil)
+var _ Getter = (*int8RateMapValue)(nil)
+
+func newInt8RateMapValue(m *map[int8]Rate) *int8RateMapValue {
+	return &int8RateMapValue{
+		value: m,
+	}
+}
+
+func (v *int8RateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int8RateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8RateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8RateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8RateMapValue) Type() string { return "map[int8]Rate" }
+
+func (v *int8RateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16RateMapValue
+type int16RateMapValue struct {
+	value *map[int16]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int16RateMapValue)(nil)
+var _ Value = (*int16RateMapValue)(nil)
+var _ Getter = (*int16RateMapValue)(nil)
+
+func newInt16RateMapValue(m *map[int16]Rate) *int16RateMapValue {
+	return &int16RateMapValue{
+		value: m,
+	}
+}
+
+func (v *int16RateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int16RateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16RateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16RateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16RateMapValue) Type() string { return "map[int16]Rate" }
+
+func (v *int16RateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32RateMapValue
+type int32RateMapValue struct {
+	value *map[int32]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int32RateMapValue)(nil)
+var _ Value = (*int32RateMapValue)(nil)
+var _ Getter = (*int32RateMapValue)(nil)
+
+func newInt32RateMapValue(m *map[int32]Rate) *int32RateMapValue {
+	return &int32RateMapValue{
+		value: m,
+	}
+}
+
+func (v *int32RateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int32RateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32RateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32RateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32RateMapValue) Type() string { return "map[int32]Rate" }
+
+func (v *int32RateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64RateMapValue
+type int64RateMapValue struct {
+	value *map[int64]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int64RateMapValue)(nil)
+var _ Value = (*int64RateMapValue)(nil)
+var _ Getter = (*int64RateMapValue)(nil)
+
+func newInt64RateMapValue(m *map[int64]Rate) *int64RateMapValue {
+	return &int64RateMapValue{
+		value: m,
+	}
+}
+
+func (v *int64RateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int64RateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64RateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64RateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64RateMapValue) Type() string { return "map[int64]Rate" }
+
+func (v *int64RateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintRateMapValue
+type uintRateMapValue struct {
+	value *map[uint]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uintRateMapValue)(nil)
+var _ Value = (*uintRateMapValue)(nil)
+var _ Getter = (*uintRateMapValue)(nil)
+
+func newUintRateMapValue(m *map[uint]Rate) *uintRateMapValue {
+	return &uintRateMapValue{
+		value: m,
+	}
+}
+
+func (v *uintRateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uintRateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintRateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintRateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintRateMapValue) Type() string { return "map[uint]Rate" }
+
+func (v *uintRateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8RateMapValue
+type uint8RateMapValue struct {
+	value *map[uint8]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint8RateMapValue)(nil)
+var _ Value = (*uint8RateMapValue)(nil)
+var _ Getter = (*uint8RateMapValue)(nil)
+
+func newUint8RateMapValue(m *map[uint8]Rate) *uint8RateMapValue {
+	return &uint8RateMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8RateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint8RateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8RateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8RateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8RateMapValue) Type() string { return "map[uint8]Rate" }
+
+func (v *uint8RateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16RateMapValue
+type uint16RateMapValue struct {
+	value *map[uint16]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint16RateMapValue)(nil)
+var _ Value = (*uint16RateMapValue)(nil)
+var _ Getter = (*uint16RateMapValue)(nil)
+
+func newUint16RateMapValue(m *map[uint16]Rate) *uint16RateMapValue {
+	return &uint16RateMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16RateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint16RateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16RateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16RateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16RateMapValue) Type() string { return "map[uint16]Rate" }
+
+func (v *uint16RateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32RateMapValue
+type uint32RateMapValue struct {
+	value *map[uint32]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint32RateMapValue)(nil)
+var _ Value = (*uint32RateMapValue)(nil)
+var _ Getter = (*uint32RateMapValue)(nil)
+
+func newUint32RateMapValue(m *map[uint32]Rate) *uint32RateMapValue {
+	return &uint32RateMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32RateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint32RateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32RateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32RateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32RateMapValue) Type() string { return "map[uint32]Rate" }
+
+func (v *uint32RateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64RateMapValue
+type uint64RateMapValue struct {
+	value *map[uint64]Rate
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint64RateMapValue)(nil)
+var _ Value = (*uint64RateMapValue)(nil)
+var _ Getter = (*uint64RateMapValue)(nil)
+
+func newUint64RateMapValue(m *map[uint64]Rate) *uint64RateMapValue {
+	return &uint64RateMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64RateMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint64RateMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := ParseRate(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64RateMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64RateMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64RateMapValue) Type() string { return "map[uint64]Rate" }
+
+func (v *uint64RateMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- ExistingFile Value
+type existingFileValue struct {
+	value *ExistingFile
+}
+
+var _ Value = (*existingFileValue)(nil)
+var _ Getter = (*existingFileValue)(nil)
+
+func newExistingFileValue(p *ExistingFile) *existingFileValue {
+	return &existingFileValue{value: p}
+}
+
+func (v *existingFileValue) Set(s string) error {
+	parsed, err := parseExistingFile(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *existingFileValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *existingFileValue) String() string {
+	if v != nil && v.value != nil {
+		return string(*v.value)
+	}
+	return ""
+}
+
+func (v *existingFileValue) Type() string { return "existingFile" }
+
+// -- ExistingFileSlice Value
+
+type existingFileSliceValue struct {
+	value   *[]ExistingFile
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*existingFileSliceValue)(nil)
+var _ Value = (*existingFileSliceValue)(nil)
+var _ Getter = (*existingFileSliceValue)(nil)
+
+func newExistingFileSliceValue(slice *[]ExistingFile) *existingFileSliceValue {
+	return &existingFileSliceValue{
+		value: slice,
+	}
+}
+
+func (v *existingFileSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *existingFileSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]ExistingFile, len(ss))
+	for i, s := range ss {
+		parsed, err := parseExistingFile(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *existingFileSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]ExistingFile)(nil)
+}
+
+func (v *existingFileSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newExistingFileValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *existingFileSliceValue) Type() string { return "existingFileSlice" }
+
+func (v *existingFileSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- ExistingDir Value
+type existingDirValue struct {
+	value *ExistingDir
+}
+
+var _ Value = (*existingDirValue)(nil)
+var _ Getter = (*existingDirValue)(nil)
+
+func newExistingDirValue(p *ExistingDir) *existingDirValue {
+	return &existingDirValue{value: p}
+}
+
+func (v *existingDirValue) Set(s string) error {
+	parsed, err := parseExistingDir(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *existingDirValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *existingDirValue) String() string {
+	if v != nil && v.value != nil {
+		return string(*v.value)
+	}
+	return ""
+}
+
+func (v *existingDirValue) Type() string { return "existingDir" }
+
+// -- ExistingDirSlice Value
+
+type existingDirSliceValue struct {
+	value   *[]ExistingDir
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*existingDirSliceValue)(nil)
+var _ Value = (*existingDirSliceValue)(nil)
+var _ Getter = (*existingDirSliceValue)(nil)
+
+func newExistingDirSliceValue(slice *[]ExistingDir) *existingDirSliceValue {
+	return &existingDirSliceValue{
+		value: slice,
+	}
+}
+
+func (v *existingDirSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *existingDirSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]ExistingDir, len(ss))
+	for i, s := range ss {
+		parsed, err := parseExistingDir(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *existingDirSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]ExistingDir)(nil)
+}
+
+func (v *existingDirSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newExistingDirValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *existingDirSliceValue) Type() string { return "existingDirSlice" }
+
+func (v *existingDirSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- FileSlice Value
+
+type fileSliceValue struct {
+	value   *[]File
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*fileSliceValue)(nil)
+var _ Value = (*fileSliceValue)(nil)
+var _ Getter = (*fileSliceValue)(nil)
+
+func newFileSliceValue(slice *[]File) *fileSliceValue {
+	return &fileSliceValue{
+		value: slice,
+	}
+}
+
+func (v *fileSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *fileSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]File, len(ss))
+	for i, s := range ss {
+		parsed, err := parseFile(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *fileSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]File)(nil)
+}
+
+func (v *fileSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newFileValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *fileSliceValue) Type() string { return "fileSlice" }
+
+func (v *fileSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.Addr Value
+type addrValue struct {
+	value *netip.Addr
+}
+
+var _ Value = (*addrValue)(nil)
+var _ Getter = (*addrValue)(nil)
+
+func newAddrValue(p *netip.Addr) *addrValue {
+	return &addrValue{value: p}
+}
+
+func (v *addrValue) Set(s string) error {
+	parsed, err := netip.ParseAddr(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *addrValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *addrValue) String() string {
+	if v != nil && v.value != nil {
+		return formatValid(*v.value)
+	}
+	return ""
+}
+
+func (v *addrValue) Type() string { return "addr" }
+
+// -- netip.AddrSlice Value
+
+type addrSliceValue struct {
+	value   *[]netip.Addr
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*addrSliceValue)(nil)
+var _ Value = (*addrSliceValue)(nil)
+var _ Getter = (*addrSliceValue)(nil)
+
+func newAddrSliceValue(slice *[]netip.Addr) *addrSliceValue {
+	return &addrSliceValue{
+		value: slice,
+	}
+}
+
+func (v *addrSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *addrSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.Addr, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParseAddr(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *addrSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]netip.Addr)(nil)
+}
+
+func (v *addrSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newAddrValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *addrSliceValue) Type() string { return "addrSlice" }
+
+func (v *addrSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- stringAddrMapValue
+type stringAddrMapValue struct {
+	value *map[string]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*stringAddrMapValue)(nil)
+var _ Value = (*stringAddrMapValue)(nil)
+var _ Getter = (*stringAddrMapValue)(nil)
+
+func newStringAddrMapValue(m *map[string]netip.Addr) *stringAddrMapValue {
+	return &stringAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *stringAddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *stringAddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	key := s
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringAddrMapValue) Type() string { return "map[string]netip.Addr" }
+
+func (v *stringAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intAddrMapValue
+type intAddrMapValue struct {
+	value *map[int]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*intAddrMapValue)(nil)
+var _ Value = (*intAddrMapValue)(nil)
+var _ Getter = (*intAddrMapValue)(nil)
+
+func newIntAddrMapValue(m *map[int]netip.Addr) *intAddrMapValue {
+	return &intAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *intAddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *intAddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intAddrMapValue) Type() string { return "map[int]netip.Addr" }
+
+func (v *intAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8AddrMapValue
+type int8AddrMapValue struct {
+	value *map[int8]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int8AddrMapValue)(nil)
+var _ Value = (*int8AddrMapValue)(nil)
+var _ Getter = (*int8AddrMapValue)(nil)
+
+func newInt8AddrMapValue(m *map[int8]netip.Addr) *int8AddrMapValue {
+	return &int8AddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int8AddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int8AddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8AddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8AddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8AddrMapValue) Type() string { return "map[int8]netip.Addr" }
+
+func (v *int8AddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16AddrMapValue
+type int16AddrMapValue struct {
+	value *map[int16]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int16AddrMapValue)(nil)
+var _ Value = (*int16AddrMapValue)(nil)
+var _ Getter = (*int16AddrMapValue)(nil)
+
+func newInt16AddrMapValue(m *map[int16]netip.Addr) *int16AddrMapValue {
+	return &int16AddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int16AddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int16AddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16AddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16AddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16AddrMapValue) Type() string { return "map[int16]netip.Addr" }
+
+func (v *int16AddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32AddrMapValue
+type int32AddrMapValue struct {
+	value *map[int32]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int32AddrMapValue)(nil)
+var _ Value = (*int32AddrMapValue)(nil)
+var _ Getter = (*int32AddrMapValue)(nil)
+
+func newInt32AddrMapValue(m *map[int32]netip.Addr) *int32AddrMapValue {
+	return &int32AddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int32AddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int32AddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32AddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32AddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32AddrMapValue) Type() string { return "map[int32]netip.Addr" }
+
+func (v *int32AddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64AddrMapValue
+type int64AddrMapValue struct {
+	value *map[int64]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int64AddrMapValue)(nil)
+var _ Value = (*int64AddrMapValue)(nil)
+var _ Getter = (*int64AddrMapValue)(nil)
+
+func newInt64AddrMapValue(m *map[int64]netip.Addr) *int64AddrMapValue {
+	return &int64AddrMapValue{
+		value: m,
+	}
+}
+
+func (v *int64AddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int64AddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64AddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64AddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64AddrMapValue) Type() string { return "map[int64]netip.Addr" }
+
+func (v *int64AddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintAddrMapValue
+type uintAddrMapValue struct {
+	value *map[uint]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uintAddrMapValue)(nil)
+var _ Value = (*uintAddrMapValue)(nil)
+var _ Getter = (*uintAddrMapValue)(nil)
+
+func newUintAddrMapValue(m *map[uint]netip.Addr) *uintAddrMapValue {
+	return &uintAddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uintAddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uintAddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintAddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintAddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintAddrMapValue) Type() string { return "map[uint]netip.Addr" }
+
+func (v *uintAddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8AddrMapValue
+type uint8AddrMapValue struct {
+	value *map[uint8]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint8AddrMapValue)(nil)
+var _ Value = (*uint8AddrMapValue)(nil)
+var _ Getter = (*uint8AddrMapValue)(nil)
+
+func newUint8AddrMapValue(m *map[uint8]netip.Addr) *uint8AddrMapValue {
+	return &uint8AddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8AddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint8AddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8AddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8AddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8AddrMapValue) Type() string { return "map[uint8]netip.Addr" }
+
+func (v *uint8AddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16AddrMapValue
+type uint16AddrMapValue struct {
+	value *map[uint16]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint16AddrMapValue)(nil)
+var _ Value = (*uint16AddrMapValue)(nil)
+var _ Getter = (*uint16AddrMapValue)(nil)
+
+func newUint16AddrMapValue(m *map[uint16]netip.Addr) *uint16AddrMapValue {
+	return &uint16AddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16AddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint16AddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16AddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16AddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16AddrMapValue) Type() string { return "map[uint16]netip.Addr" }
+
+func (v *uint16AddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32AddrMapValue
+type uint32AddrMapValue struct {
+	value *map[uint32]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint32AddrMapValue)(nil)
+var _ Value = (*uint32AddrMapValue)(nil)
+var _ Getter = (*uint32AddrMapValue)(nil)
+
+func newUint32AddrMapValue(m *map[uint32]netip.Addr) *uint32AddrMapValue {
+	return &uint32AddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32AddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint32AddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32AddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32AddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32AddrMapValue) Type() string { return "map[uint32]netip.Addr" }
+
+func (v *uint32AddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64AddrMapValue
+type uint64AddrMapValue struct {
+	value *map[uint64]netip.Addr
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint64AddrMapValue)(nil)
+var _ Value = (*uint64AddrMapValue)(nil)
+var _ Getter = (*uint64AddrMapValue)(nil)
+
+func newUint64AddrMapValue(m *map[uint64]netip.Addr) *uint64AddrMapValue {
+	return &uint64AddrMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64AddrMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint64AddrMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64AddrMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64AddrMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64AddrMapValue) Type() string { return "map[uint64]netip.Addr" }
+
+func (v *uint64AddrMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.Prefix Value
+type prefixValue struct {
+	value *netip.Prefix
+}
+
+var _ Value = (*prefixValue)(nil)
+var _ Getter = (*prefixValue)(nil)
+
+func newPrefixValue(p *netip.Prefix) *prefixValue {
+	return &prefixValue{value: p}
+}
+
+func (v *prefixValue) Set(s string) error {
+	parsed, err := netip.ParsePrefix(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *prefixValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *prefixValue) String() string {
+	if v != nil && v.value != nil {
+		return formatValid(*v.value)
+	}
+	return ""
+}
+
+func (v *prefixValue) Type() string { return "prefix" }
+
+// -- netip.PrefixSlice Value
+
+type prefixSliceValue struct {
+	value   *[]netip.Prefix
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*prefixSliceValue)(nil)
+var _ Value = (*prefixSliceValue)(nil)
+var _ Getter = (*prefixSliceValue)(nil)
+
+func newPrefixSliceValue(slice *[]netip.Prefix) *prefixSliceValue {
+	return &prefixSliceValue{
+		value: slice,
+	}
+}
+
+func (v *prefixSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *prefixSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.Prefix, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParsePrefix(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *prefixSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]netip.Prefix)(nil)
+}
+
+func (v *prefixSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newPrefixValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *prefixSliceValue) Type() string { return "prefixSlice" }
+
+func (v *prefixSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- stringPrefixMapValue
+type stringPrefixMapValue struct {
+	value *map[string]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*stringPrefixMapValue)(nil)
+var _ Value = (*stringPrefixMapValue)(nil)
+var _ Getter = (*stringPrefixMapValue)(nil)
+
+func newStringPrefixMapValue(m *map[string]netip.Prefix) *stringPrefixMapValue {
+	return &stringPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *stringPrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *stringPrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	key := s
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringPrefixMapValue) Type() string { return "map[string]netip.Prefix" }
+
+func (v *stringPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intPrefixMapValue
+type intPrefixMapValue struct {
+	value *map[int]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*intPrefixMapValue)(nil)
+var _ Value = (*intPrefixMapValue)(nil)
+var _ Getter = (*intPrefixMapValue)(nil)
+
+func newIntPrefixMapValue(m *map[int]netip.Prefix) *intPrefixMapValue {
+	return &intPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *intPrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *intPrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intPrefixMapValue) Type() string { return "map[int]netip.Prefix" }
+
+func (v *intPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8PrefixMapValue
+type int8PrefixMapValue struct {
+	value *map[int8]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int8PrefixMapValue)(nil)
+var _ Value = (*int8PrefixMapValue)(nil)
+var _ Getter = (*int8PrefixMapValue)(nil)
+
+func newInt8PrefixMapValue(m *map[int8]netip.Prefix) *int8PrefixMapValue {
+	return &int8PrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *int8PrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int8PrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8PrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8PrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8PrefixMapValue) Type() string { return "map[int8]netip.Prefix" }
+
+func (v *int8PrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16PrefixMapValue
+type int16PrefixMapValue struct {
+	value *map[int16]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int16PrefixMapValue)(nil)
+var _ Value = (*int16PrefixMapValue)(nil)
+var _ Getter = (*int16PrefixMapValue)(nil)
+
+func newInt16PrefixMapValue(m *map[int16]netip.Prefix) *int16PrefixMapValue {
+	return &int16PrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *int16PrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int16PrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16PrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16PrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16PrefixMapValue) Type() string { return "map[int16]netip.Prefix" }
+
+func (v *int16PrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32PrefixMapValue
+type int32PrefixMapValue struct {
+	value *map[int32]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int32PrefixMapValue)(nil)
+var _ Value = (*int32PrefixMapValue)(nil)
+var _ Getter = (*int32PrefixMapValue)(nil)
+
+func newInt32PrefixMapValue(m *map[int32]netip.Prefix) *int32PrefixMapValue {
+	return &int32PrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *int32PrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int32PrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32PrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32PrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32PrefixMapValue) Type() string { return "map[int32]netip.Prefix" }
+
+func (v *int32PrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64PrefixMapValue
+type int64PrefixMapValue struct {
+	value *map[int64]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int64PrefixMapValue)(nil)
+var _ Value = (*int64PrefixMapValue)(nil)
+var _ Getter = (*int64PrefixMapValue)(nil)
+
+func newInt64PrefixMapValue(m *map[int64]netip.Prefix) *int64PrefixMapValue {
+	return &int64PrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *int64PrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int64PrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64PrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64PrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64PrefixMapValue) Type() string { return "map[int64]netip.Prefix" }
+
+func (v *int64PrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintPrefixMapValue
+type uintPrefixMapValue struct {
+	value *map[uint]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uintPrefixMapValue)(nil)
+var _ Value = (*uintPrefixMapValue)(nil)
+var _ Getter = (*uintPrefixMapValue)(nil)
+
+func newUintPrefixMapValue(m *map[uint]netip.Prefix) *uintPrefixMapValue {
+	return &uintPrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uintPrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uintPrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintPrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintPrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintPrefixMapValue) Type() string { return "map[uint]netip.Prefix" }
+
+func (v *uintPrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8PrefixMapValue
+type uint8PrefixMapValue struct {
+	value *map[uint8]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint8PrefixMapValue)(nil)
+var _ Value = (*uint8PrefixMapValue)(nil)
+var _ Getter = (*uint8PrefixMapValue)(nil)
+
+func newUint8PrefixMapValue(m *map[uint8]netip.Prefix) *uint8PrefixMapValue {
+	return &uint8PrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8PrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint8PrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8PrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8PrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8PrefixMapValue) Type() string { return "map[uint8]netip.Prefix" }
+
+func (v *uint8PrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16PrefixMapValue
+type uint16PrefixMapValue struct {
+	value *map[uint16]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint16PrefixMapValue)(nil)
+var _ Value = (*uint16PrefixMapValue)(nil)
+var _ Getter = (*uint16PrefixMapValue)(nil)
+
+func newUint16PrefixMapValue(m *map[uint16]netip.Prefix) *uint16PrefixMapValue {
+	return &uint16PrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16PrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint16PrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16PrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16PrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16PrefixMapValue) Type() string { return "map[uint16]netip.Prefix" }
+
+func (v *uint16PrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32PrefixMapValue
+type uint32PrefixMapValue struct {
+	value *map[uint32]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint32PrefixMapValue)(nil)
+var _ Value = (*uint32PrefixMapValue)(nil)
+var _ Getter = (*uint32PrefixMapValue)(nil)
+
+func newUint32PrefixMapValue(m *map[uint32]netip.Prefix) *uint32PrefixMapValue {
+	return &uint32PrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32PrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint32PrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32PrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32PrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32PrefixMapValue) Type() string { return "map[uint32]netip.Prefix" }
+
+func (v *uint32PrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64PrefixMapValue
+type uint64PrefixMapValue struct {
+	value *map[uint64]netip.Prefix
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint64PrefixMapValue)(nil)
+var _ Value = (*uint64PrefixMapValue)(nil)
+var _ Getter = (*uint64PrefixMapValue)(nil)
+
+func newUint64PrefixMapValue(m *map[uint64]netip.Prefix) *uint64PrefixMapValue {
+	return &uint64PrefixMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64PrefixMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint64PrefixMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64PrefixMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64PrefixMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64PrefixMapValue) Type() string { return "map[uint64]netip.Prefix" }
+
+func (v *uint64PrefixMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.AddrPort Value
+type addrPortValue struct {
+	value *netip.AddrPort
+}
+
+var _ Value = (*addrPortValue)(nil)
+var _ Getter = (*addrPortValue)(nil)
+
+func newAddrPortValue(p *netip.AddrPort) *addrPortValue {
+	return &addrPortValue{value: p}
+}
+
+func (v *addrPortValue) Set(s string) error {
+	parsed, err := netip.ParseAddrPort(s)
+	if err == nil {
+		*v.value = parsed
+		return nil
+	}
+	return err
+}
+
+func (v *addrPortValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *addrPortValue) String() string {
+	if v != nil && v.value != nil {
+		return formatValid(*v.value)
+	}
+	return ""
+}
+
+func (v *addrPortValue) Type() string { return "addrPort" }
+
+// -- netip.AddrPortSlice Value
+
+type addrPortSliceValue struct {
+	value   *[]netip.AddrPort
+	changed bool
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*addrPortSliceValue)(nil)
+var _ Value = (*addrPortSliceValue)(nil)
+var _ Getter = (*addrPortSliceValue)(nil)
+
+func newAddrPortSliceValue(slice *[]netip.AddrPort) *addrPortSliceValue {
+	return &addrPortSliceValue{
+		value: slice,
+	}
+}
+
+func (v *addrPortSliceValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *addrPortSliceValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.AddrPort, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParseAddrPort(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if !v.changed {
+		*v.value = out
+	} else {
+		*v.value = append(*v.value, out...)
+	}
+	v.changed = true
+	return nil
+}
+
+func (v *addrPortSliceValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return ([]netip.AddrPort)(nil)
+}
+
+func (v *addrPortSliceValue) String() string {
+	if v == nil || v.value == nil {
+		return "[]"
+	}
+	out := make([]string, 0, len(*v.value))
+	for _, elem := range *v.value {
+		out = append(out, newAddrPortValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *addrPortSliceValue) Type() string { return "addrPortSlice" }
+
+func (v *addrPortSliceValue) IsCumulative() bool {
+	return true
+}
+
+// -- stringAddrPortMapValue
+type stringAddrPortMapValue struct {
+	value *map[string]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*stringAddrPortMapValue)(nil)
+var _ Value = (*stringAddrPortMapValue)(nil)
+var _ Getter = (*stringAddrPortMapValue)(nil)
+
+func newStringAddrPortMapValue(m *map[string]netip.AddrPort) *stringAddrPortMapValue {
+	return &stringAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *stringAddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *stringAddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	key := s
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *stringAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *stringAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *stringAddrPortMapValue) Type() string { return "map[string]netip.AddrPort" }
+
+func (v *stringAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- intAddrPortMapValue
+type intAddrPortMapValue struct {
+	value *map[int]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*intAddrPortMapValue)(nil)
+var _ Value = (*intAddrPortMapValue)(nil)
+var _ Getter = (*intAddrPortMapValue)(nil)
+
+func newIntAddrPortMapValue(m *map[int]netip.AddrPort) *intAddrPortMapValue {
+	return &intAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *intAddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *intAddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (int)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *intAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *intAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *intAddrPortMapValue) Type() string { return "map[int]netip.AddrPort" }
+
+func (v *intAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8AddrPortMapValue
+type int8AddrPortMapValue struct {
+	value *map[int8]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int8AddrPortMapValue)(nil)
+var _ Value = (*int8AddrPortMapValue)(nil)
+var _ Getter = (*int8AddrPortMapValue)(nil)
+
+func newInt8AddrPortMapValue(m *map[int8]netip.AddrPort) *int8AddrPortMapValue {
+	return &int8AddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *int8AddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int8AddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (int8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int8AddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int8AddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int8AddrPortMapValue) Type() string { return "map[int8]netip.AddrPort" }
+
+func (v *int8AddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16AddrPortMapValue
+type int16AddrPortMapValue struct {
+	value *map[int16]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int16AddrPortMapValue)(nil)
+var _ Value = (*int16AddrPortMapValue)(nil)
+var _ Getter = (*int16AddrPortMapValue)(nil)
+
+func newInt16AddrPortMapValue(m *map[int16]netip.AddrPort) *int16AddrPortMapValue {
+	return &int16AddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *int16AddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int16AddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (int16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int16AddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int16AddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int16AddrPortMapValue) Type() string { return "map[int16]netip.AddrPort" }
+
+func (v *int16AddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32AddrPortMapValue
+type int32AddrPortMapValue struct {
+	value *map[int32]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int32AddrPortMapValue)(nil)
+var _ Value = (*int32AddrPortMapValue)(nil)
+var _ Getter = (*int32AddrPortMapValue)(nil)
+
+func newInt32AddrPortMapValue(m *map[int32]netip.AddrPort) *int32AddrPortMapValue {
+	return &int32AddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *int32AddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int32AddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (int32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int32AddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int32AddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int32AddrPortMapValue) Type() string { return "map[int32]netip.AddrPort" }
+
+func (v *int32AddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64AddrPortMapValue
+type int64AddrPortMapValue struct {
+	value *map[int64]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*int64AddrPortMapValue)(nil)
+var _ Value = (*int64AddrPortMapValue)(nil)
+var _ Getter = (*int64AddrPortMapValue)(nil)
+
+func newInt64AddrPortMapValue(m *map[int64]netip.AddrPort) *int64AddrPortMapValue {
+	return &int64AddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *int64AddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int64AddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *int64AddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *int64AddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *int64AddrPortMapValue) Type() string { return "map[int64]netip.AddrPort" }
+
+func (v *int64AddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintAddrPortMapValue
+type uintAddrPortMapValue struct {
+	value *map[uint]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uintAddrPortMapValue)(nil)
+var _ Value = (*uintAddrPortMapValue)(nil)
+var _ Getter = (*uintAddrPortMapValue)(nil)
+
+func newUintAddrPortMapValue(m *map[uint]netip.AddrPort) *uintAddrPortMapValue {
+	return &uintAddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uintAddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uintAddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := (uint)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uintAddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uintAddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uintAddrPortMapValue) Type() string { return "map[uint]netip.AddrPort" }
+
+func (v *uintAddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8AddrPortMapValue
+type uint8AddrPortMapValue struct {
+	value *map[uint8]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint8AddrPortMapValue)(nil)
+var _ Value = (*uint8AddrPortMapValue)(nil)
+var _ Getter = (*uint8AddrPortMapValue)(nil)
+
+func newUint8AddrPortMapValue(m *map[uint8]netip.AddrPort) *uint8AddrPortMapValue {
+	return &uint8AddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uint8AddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint8AddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	key := (uint8)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint8AddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint8AddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint8AddrPortMapValue) Type() string { return "map[uint8]netip.AddrPort" }
+
+func (v *uint8AddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16AddrPortMapValue
+type uint16AddrPortMapValue struct {
+	value *map[uint16]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint16AddrPortMapValue)(nil)
+var _ Value = (*uint16AddrPortMapValue)(nil)
+var _ Getter = (*uint16AddrPortMapValue)(nil)
+
+func newUint16AddrPortMapValue(m *map[uint16]netip.AddrPort) *uint16AddrPortMapValue {
+	return &uint16AddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uint16AddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint16AddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return err
+	}
+
+	key := (uint16)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint16AddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint16AddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint16AddrPortMapValue) Type() string { return "map[uint16]netip.AddrPort" }
+
+func (v *uint16AddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32AddrPortMapValue
+type uint32AddrPortMapValue struct {
+	value *map[uint32]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint32AddrPortMapValue)(nil)
+var _ Value = (*uint32AddrPortMapValue)(nil)
+var _ Getter = (*uint32AddrPortMapValue)(nil)
+
+func newUint32AddrPortMapValue(m *map[uint32]netip.AddrPort) *uint32AddrPortMapValue {
+	return &uint32AddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uint32AddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint32AddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+
+	key := (uint32)(parsedKey)
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint32AddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint32AddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint32AddrPortMapValue) Type() string { return "map[uint32]netip.AddrPort" }
+
+func (v *uint32AddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64AddrPortMapValue
+type uint64AddrPortMapValue struct {
+	value *map[uint64]netip.AddrPort
+	seps  *separators
+}
+
+var _ RepeatableFlag = (*uint64AddrPortMapValue)(nil)
+var _ Value = (*uint64AddrPortMapValue)(nil)
+var _ Getter = (*uint64AddrPortMapValue)(nil)
+
+func newUint64AddrPortMapValue(m *map[uint64]netip.AddrPort) *uint64AddrPortMapValue {
+	return &uint64AddrPortMapValue{
+		value: m,
+	}
+}
+
+func (v *uint64AddrPortMapValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint64AddrPortMapValue) Set(s string) error {
+	ss, err := v.seps.splitKV(s)
+	if err != nil {
+		return err
+	}
+
+	s = ss[0]
+
+	parsedKey, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return err
+	}
+
+	key := parsedKey
+
+	s = ss[1]
+
+	parsedVal, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return err
+	}
+
+	val := parsedVal
+
+	(*v.value)[key] = val
+
+	return nil
+}
+
+func (v *uint64AddrPortMapValue) Get() interface{} {
+	if v != nil && v.value != nil {
+		return *v.value
+	}
+	return nil
+}
+
+func (v *uint64AddrPortMapValue) String() string {
+	if v != nil && v.value != nil && len(*v.value) > 0 {
+		return fmt.Sprintf("%v", *v.value)
+	}
+	return ""
+}
+
+func (v *uint64AddrPortMapValue) Type() string { return "map[uint64]netip.AddrPort" }
+
+func (v *uint64AddrPortMapValue) IsCumulative() bool {
+	return true
+}
+
+// -- stringArray Value
+
+type stringArrayValue struct {
+	value   []string // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*stringArrayValue)(nil)
+var _ Value = (*stringArrayValue)(nil)
+var _ Getter = (*stringArrayValue)(nil)
+
+func newStringArrayValue(array reflect.Value) *stringArrayValue {
+	return &stringArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]string),
+		array: array,
+	}
+}
+
+func (v *stringArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *stringArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+	out := ss
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *stringArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *stringArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *stringArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newStringValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *stringArrayValue) Type() string { return "stringArray" }
+
+func (v *stringArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- boolArray Value
+
+type boolArrayValue struct {
+	value   []bool // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*boolArrayValue)(nil)
+var _ Value = (*boolArrayValue)(nil)
+var _ Getter = (*boolArrayValue)(nil)
+
+func newBoolArrayValue(array reflect.Value) *boolArrayValue {
+	return &boolArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]bool),
+		array: array,
+	}
+}
+
+func (v *boolArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *boolArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]bool, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *boolArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *boolArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *boolArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newBoolValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *boolArrayValue) Type() string { return "boolArray" }
+
+func (v *boolArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- uintArray Value
+
+type uintArrayValue struct {
+	value   []uint // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*uintArrayValue)(nil)
+var _ Value = (*uintArrayValue)(nil)
+var _ Getter = (*uintArrayValue)(nil)
+
+func newUintArrayValue(array reflect.Value) *uintArrayValue {
+	return &uintArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]uint),
+		array: array,
+	}
+}
+
+func (v *uintArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uintArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]uint, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			return err
+		}
+		out[i] = (uint)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *uintArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *uintArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *uintArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newUintValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *uintArrayValue) Type() string { return "uintArray" }
+
+func (v *uintArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint8Array Value
+
+type uint8ArrayValue struct {
+	value   []uint8 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*uint8ArrayValue)(nil)
+var _ Value = (*uint8ArrayValue)(nil)
+var _ Getter = (*uint8ArrayValue)(nil)
+
+func newUint8ArrayValue(array reflect.Value) *uint8ArrayValue {
+	return &uint8ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]uint8),
+		array: array,
+	}
+}
+
+func (v *uint8ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint8ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]uint8, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseUint(s, 0, 8)
+		if err != nil {
+			return err
+		}
+		out[i] = (uint8)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *uint8ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *uint8ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *uint8ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newUint8Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *uint8ArrayValue) Type() string { return "uint8Array" }
+
+func (v *uint8ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint16Array Value
+
+type uint16ArrayValue struct {
+	value   []uint16 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*uint16ArrayValue)(nil)
+var _ Value = (*uint16ArrayValue)(nil)
+var _ Getter = (*uint16ArrayValue)(nil)
+
+func newUint16ArrayValue(array reflect.Value) *uint16ArrayValue {
+	return &uint16ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]uint16),
+		array: array,
+	}
+}
+
+func (v *uint16ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint16ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]uint16, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseUint(s, 0, 16)
+		if err != nil {
+			return err
+		}
+		out[i] = (uint16)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *uint16ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *uint16ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *uint16ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newUint16Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *uint16ArrayValue) Type() string { return "uint16Array" }
+
+func (v *uint16ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint32Array Value
+
+type uint32ArrayValue struct {
+	value   []uint32 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*uint32ArrayValue)(nil)
+var _ Value = (*uint32ArrayValue)(nil)
+var _ Getter = (*uint32ArrayValue)(nil)
+
+func newUint32ArrayValue(array reflect.Value) *uint32ArrayValue {
+	return &uint32ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]uint32),
+		array: array,
+	}
+}
+
+func (v *uint32ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint32ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]uint32, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseUint(s, 0, 32)
+		if err != nil {
+			return err
+		}
+		out[i] = (uint32)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *uint32ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *uint32ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *uint32ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newUint32Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *uint32ArrayValue) Type() string { return "uint32Array" }
+
+func (v *uint32ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- uint64Array Value
+
+type uint64ArrayValue struct {
+	value   []uint64 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*uint64ArrayValue)(nil)
+var _ Value = (*uint64ArrayValue)(nil)
+var _ Getter = (*uint64ArrayValue)(nil)
+
+func newUint64ArrayValue(array reflect.Value) *uint64ArrayValue {
+	return &uint64ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]uint64),
+		array: array,
+	}
+}
+
+func (v *uint64ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *uint64ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]uint64, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *uint64ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *uint64ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *uint64ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newUint64Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *uint64ArrayValue) Type() string { return "uint64Array" }
+
+func (v *uint64ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- intArray Value
+
+type intArrayValue struct {
+	value   []int // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*intArrayValue)(nil)
+var _ Value = (*intArrayValue)(nil)
+var _ Getter = (*intArrayValue)(nil)
+
+func newIntArrayValue(array reflect.Value) *intArrayValue {
+	return &intArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]int),
+		array: array,
+	}
+}
+
+func (v *intArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *intArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]int, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			return err
+		}
+		out[i] = (int)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *intArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *intArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *intArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newIntValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *intArrayValue) Type() string { return "intArray" }
+
+func (v *intArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- int8Array Value
+
+type int8ArrayValue struct {
+	value   []int8 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*int8ArrayValue)(nil)
+var _ Value = (*int8ArrayValue)(nil)
+var _ Getter = (*int8ArrayValue)(nil)
+
+func newInt8ArrayValue(array reflect.Value) *int8ArrayValue {
+	return &int8ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]int8),
+		array: array,
+	}
+}
+
+func (v *int8ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int8ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]int8, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseInt(s, 0, 8)
+		if err != nil {
+			return err
+		}
+		out[i] = (int8)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *int8ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *int8ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *int8ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newInt8Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *int8ArrayValue) Type() string { return "int8Array" }
+
+func (v *int8ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- int16Array Value
+
+type int16ArrayValue struct {
+	value   []int16 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*int16ArrayValue)(nil)
+var _ Value = (*int16ArrayValue)(nil)
+var _ Getter = (*int16ArrayValue)(nil)
+
+func newInt16ArrayValue(array reflect.Value) *int16ArrayValue {
+	return &int16ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]int16),
+		array: array,
+	}
+}
+
+func (v *int16ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int16ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]int16, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseInt(s, 0, 16)
+		if err != nil {
+			return err
+		}
+		out[i] = (int16)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *int16ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *int16ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *int16ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newInt16Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *int16ArrayValue) Type() string { return "int16Array" }
+
+func (v *int16ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- int32Array Value
+
+type int32ArrayValue struct {
+	value   []int32 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*int32ArrayValue)(nil)
+var _ Value = (*int32ArrayValue)(nil)
+var _ Getter = (*int32ArrayValue)(nil)
+
+func newInt32ArrayValue(array reflect.Value) *int32ArrayValue {
+	return &int32ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]int32),
+		array: array,
+	}
+}
+
+func (v *int32ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int32ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]int32, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseInt(s, 0, 32)
+		if err != nil {
+			return err
+		}
+		out[i] = (int32)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *int32ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *int32ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *int32ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newInt32Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *int32ArrayValue) Type() string { return "int32Array" }
+
+func (v *int32ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- int64Array Value
+
+type int64ArrayValue struct {
+	value   []int64 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*int64ArrayValue)(nil)
+var _ Value = (*int64ArrayValue)(nil)
+var _ Getter = (*int64ArrayValue)(nil)
+
+func newInt64ArrayValue(array reflect.Value) *int64ArrayValue {
+	return &int64ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]int64),
+		array: array,
+	}
+}
+
+func (v *int64ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *int64ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]int64, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *int64ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *int64ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *int64ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newInt64Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *int64ArrayValue) Type() string { return "int64Array" }
+
+func (v *int64ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- float64Array Value
+
+type float64ArrayValue struct {
+	value   []float64 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*float64ArrayValue)(nil)
+var _ Value = (*float64ArrayValue)(nil)
+var _ Getter = (*float64ArrayValue)(nil)
+
+func newFloat64ArrayValue(array reflect.Value) *float64ArrayValue {
+	return &float64ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]float64),
+		array: array,
+	}
+}
+
+func (v *float64ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *float64ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]float64, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *float64ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *float64ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *float64ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newFloat64Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *float64ArrayValue) Type() string { return "float64Array" }
+
+func (v *float64ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- float32Array Value
+
+type float32ArrayValue struct {
+	value   []float32 // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*float32ArrayValue)(nil)
+var _ Value = (*float32ArrayValue)(nil)
+var _ Getter = (*float32ArrayValue)(nil)
+
+func newFloat32ArrayValue(array reflect.Value) *float32ArrayValue {
+	return &float32ArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]float32),
+		array: array,
+	}
+}
+
+func (v *float32ArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *float32ArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]float32, len(ss))
+	for i, s := range ss {
+		parsed, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return err
+		}
+		out[i] = (float32)(parsed)
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *float32ArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *float32ArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *float32ArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newFloat32Value(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *float32ArrayValue) Type() string { return "float32Array" }
+
+func (v *float32ArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- time.DurationArray Value
+
+type durationArrayValue struct {
+	value   []time.Duration // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*durationArrayValue)(nil)
+var _ Value = (*durationArrayValue)(nil)
+var _ Getter = (*durationArrayValue)(nil)
+
+func newDurationArrayValue(array reflect.Value) *durationArrayValue {
+	return &durationArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]time.Duration),
+		array: array,
+	}
+}
+
+func (v *durationArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *durationArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]time.Duration, len(ss))
+	for i, s := range ss {
+		parsed, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *durationArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *durationArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *durationArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newDurationValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *durationArrayValue) Type() string { return "durationArray" }
+
+func (v *durationArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- net.IPArray Value
+
+type ipArrayValue struct {
+	value   []net.IP // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*ipArrayValue)(nil)
+var _ Value = (*ipArrayValue)(nil)
+var _ Getter = (*ipArrayValue)(nil)
+
+func newIPArrayValue(array reflect.Value) *ipArrayValue {
+	return &ipArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]net.IP),
+		array: array,
+	}
+}
+
+func (v *ipArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *ipArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]net.IP, len(ss))
+	for i, s := range ss {
+		parsed, err := parseIP(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *ipArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *ipArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *ipArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newIPValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *ipArrayValue) Type() string { return "ipArray" }
+
+func (v *ipArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- HexBytesArray Value
+
+type hexBytesArrayValue struct {
+	value   []HexBytes // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*hexBytesArrayValue)(nil)
+var _ Value = (*hexBytesArrayValue)(nil)
+var _ Getter = (*hexBytesArrayValue)(nil)
+
+func newHexBytesArrayValue(array reflect.Value) *hexBytesArrayValue {
+	return &hexBytesArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]HexBytes),
+		array: array,
+	}
+}
+
+func (v *hexBytesArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *hexBytesArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]HexBytes, len(ss))
+	for i, s := range ss {
+		parsed, err := hex.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *hexBytesArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *hexBytesArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *hexBytesArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newHexBytesValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *hexBytesArrayValue) Type() string { return "hexBytesArray" }
+
+func (v *hexBytesArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- *regexp.RegexpArray Value
+
+type regexpArrayValue struct {
+	value   []*regexp.Regexp // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*regexpArrayValue)(nil)
+var _ Value = (*regexpArrayValue)(nil)
+var _ Getter = (*regexpArrayValue)(nil)
+
+func newRegexpArrayValue(array reflect.Value) *regexpArrayValue {
+	return &regexpArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]*regexp.Regexp),
+		array: array,
+	}
+}
+
+func (v *regexpArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *regexpArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]*regexp.Regexp, len(ss))
+	for i, s := range ss {
+		parsed, err := regexp.Compile(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *regexpArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *regexpArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *regexpArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newRegexpValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *regexpArrayValue) Type() string { return "regexpArray" }
+
+func (v *regexpArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- net.TCPAddrArray Value
+
+type tcpAddrArrayValue struct {
+	value   []net.TCPAddr // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*tcpAddrArrayValue)(nil)
+var _ Value = (*tcpAddrArrayValue)(nil)
+var _ Getter = (*tcpAddrArrayValue)(nil)
+
+func newTCPAddrArrayValue(array reflect.Value) *tcpAddrArrayValue {
+	return &tcpAddrArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]net.TCPAddr),
+		array: array,
+	}
+}
+
+func (v *tcpAddrArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *tcpAddrArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]net.TCPAddr, len(ss))
+	for i, s := range ss {
+		parsed, err := parseTCPAddr(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *tcpAddrArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *tcpAddrArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *tcpAddrArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newTCPAddrValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *tcpAddrArrayValue) Type() string { return "tcpAddrArray" }
+
+func (v *tcpAddrArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- net.IPNetArray Value
+
+type ipNetArrayValue struct {
+	value   []net.IPNet // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*ipNetArrayValue)(nil)
+var _ Value = (*ipNetArrayValue)(nil)
+var _ Getter = (*ipNetArrayValue)(nil)
+
+func newIPNetArrayValue(array reflect.Value) *ipNetArrayValue {
+	return &ipNetArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]net.IPNet),
+		array: array,
+	}
+}
+
+func (v *ipNetArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *ipNetArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]net.IPNet, len(ss))
+	for i, s := range ss {
+		parsed, err := parseIPNet(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *ipNetArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *ipNetArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *ipNetArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newIPNetValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *ipNetArrayValue) Type() string { return "ipNetArray" }
+
+func (v *ipNetArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- url.URLArray Value
+
+type urlArrayValue struct {
+	value   []url.URL // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*urlArrayValue)(nil)
+var _ Value = (*urlArrayValue)(nil)
+var _ Getter = (*urlArrayValue)(nil)
+
+func newURLArrayValue(array reflect.Value) *urlArrayValue {
+	return &urlArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]url.URL),
+		array: array,
+	}
+}
+
+func (v *urlArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *urlArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]url.URL, len(ss))
+	for i, s := range ss {
+		parsed, err := parseURL(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *urlArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *urlArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *urlArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newURLValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *urlArrayValue) Type() string { return "urlArray" }
+
+func (v *urlArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- ByteSizeArray Value
+
+type byteSizeArrayValue struct {
+	value   []ByteSize // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*byteSizeArrayValue)(nil)
+var _ Value = (*byteSizeArrayValue)(nil)
+var _ Getter = (*byteSizeArrayValue)(nil)
+
+func newByteSizeArrayValue(array reflect.Value) *byteSizeArrayValue {
+	return &byteSizeArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]ByteSize),
+		array: array,
+	}
+}
+
+func (v *byteSizeArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *byteSizeArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]ByteSize, len(ss))
+	for i, s := range ss {
+		parsed, err := ParseByteSize(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *byteSizeArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *byteSizeArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *byteSizeArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newByteSizeValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *byteSizeArrayValue) Type() string { return "byteSizeArray" }
+
+func (v *byteSizeArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- RateArray Value
+
+type rateArrayValue struct {
+	value   []Rate // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*rateArrayValue)(nil)
+var _ Value = (*rateArrayValue)(nil)
+var _ Getter = (*rateArrayValue)(nil)
+
+func newRateArrayValue(array reflect.Value) *rateArrayValue {
+	return &rateArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]Rate),
+		array: array,
+	}
+}
+
+func (v *rateArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *rateArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]Rate, len(ss))
+	for i, s := range ss {
+		parsed, err := ParseRate(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *rateArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *rateArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *rateArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newRateValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *rateArrayValue) Type() string { return "rateArray" }
+
+func (v *rateArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- ExistingFileArray Value
+
+type existingFileArrayValue struct {
+	value   []ExistingFile // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*existingFileArrayValue)(nil)
+var _ Value = (*existingFileArrayValue)(nil)
+var _ Getter = (*existingFileArrayValue)(nil)
+
+func newExistingFileArrayValue(array reflect.Value) *existingFileArrayValue {
+	return &existingFileArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]ExistingFile),
+		array: array,
+	}
+}
+
+func (v *existingFileArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *existingFileArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]ExistingFile, len(ss))
+	for i, s := range ss {
+		parsed, err := parseExistingFile(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *existingFileArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *existingFileArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *existingFileArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newExistingFileValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *existingFileArrayValue) Type() string { return "existingFileArray" }
+
+func (v *existingFileArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- ExistingDirArray Value
+
+type existingDirArrayValue struct {
+	value   []ExistingDir // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*existingDirArrayValue)(nil)
+var _ Value = (*existingDirArrayValue)(nil)
+var _ Getter = (*existingDirArrayValue)(nil)
+
+func newExistingDirArrayValue(array reflect.Value) *existingDirArrayValue {
+	return &existingDirArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]ExistingDir),
+		array: array,
+	}
+}
+
+func (v *existingDirArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *existingDirArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]ExistingDir, len(ss))
+	for i, s := range ss {
+		parsed, err := parseExistingDir(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *existingDirArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *existingDirArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *existingDirArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newExistingDirValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *existingDirArrayValue) Type() string { return "existingDirArray" }
+
+func (v *existingDirArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- FileArray Value
+
+type fileArrayValue struct {
+	value   []File // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*fileArrayValue)(nil)
+var _ Value = (*fileArrayValue)(nil)
+var _ Getter = (*fileArrayValue)(nil)
+
+func newFileArrayValue(array reflect.Value) *fileArrayValue {
+	return &fileArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]File),
+		array: array,
+	}
+}
+
+func (v *fileArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *fileArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]File, len(ss))
+	for i, s := range ss {
+		parsed, err := parseFile(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *fileArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *fileArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *fileArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newFileValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *fileArrayValue) Type() string { return "fileArray" }
+
+func (v *fileArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- time.TimeArray Value
+
+type timeArrayValue struct {
+	value   []time.Time // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*timeArrayValue)(nil)
+var _ Value = (*timeArrayValue)(nil)
+var _ Getter = (*timeArrayValue)(nil)
+
+func newTimeArrayValue(array reflect.Value) *timeArrayValue {
+	return &timeArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]time.Time),
+		array: array,
+	}
+}
+
+func (v *timeArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *timeArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]time.Time, len(ss))
+	for i, s := range ss {
+		parsed, err := parseTime(s, "")
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *timeArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *timeArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *timeArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newTimeValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *timeArrayValue) Type() string { return "timeArray" }
+
+func (v *timeArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.AddrArray Value
+
+type addrArrayValue struct {
+	value   []netip.Addr // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*addrArrayValue)(nil)
+var _ Value = (*addrArrayValue)(nil)
+var _ Getter = (*addrArrayValue)(nil)
+
+func newAddrArrayValue(array reflect.Value) *addrArrayValue {
+	return &addrArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]netip.Addr),
+		array: array,
+	}
+}
+
+func (v *addrArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *addrArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.Addr, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParseAddr(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *addrArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *addrArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *addrArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newAddrValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *addrArrayValue) Type() string { return "addrArray" }
+
+func (v *addrArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.PrefixArray Value
+
+type prefixArrayValue struct {
+	value   []netip.Prefix // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*prefixArrayValue)(nil)
+var _ Value = (*prefixArrayValue)(nil)
+var _ Getter = (*prefixArrayValue)(nil)
+
+func newPrefixArrayValue(array reflect.Value) *prefixArrayValue {
+	return &prefixArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]netip.Prefix),
+		array: array,
+	}
+}
+
+func (v *prefixArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *prefixArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.Prefix, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParsePrefix(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *prefixArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *prefixArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *prefixArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newPrefixValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *prefixArrayValue) Type() string { return "prefixArray" }
+
+func (v *prefixArrayValue) IsCumulative() bool {
+	return true
+}
+
+// -- netip.AddrPortArray Value
+
+type addrPortArrayValue struct {
+	value   []netip.AddrPort // elements of the array
+	array   reflect.Value
+	changed int // number of elements set by repeated flags
+	seps    *separators
+}
+
+var _ RepeatableFlag = (*addrPortArrayValue)(nil)
+var _ Value = (*addrPortArrayValue)(nil)
+var _ Getter = (*addrPortArrayValue)(nil)
+
+func newAddrPortArrayValue(array reflect.Value) *addrPortArrayValue {
+	return &addrPortArrayValue{
+		value: array.Slice(0, array.Len()).Interface().([]netip.AddrPort),
+		array: array,
+	}
+}
+
+func (v *addrPortArrayValue) setSeparators(seps *separators) { v.seps = seps }
+
+func (v *addrPortArrayValue) Set(raw string) error {
+	ss, err := v.seps.split(raw)
+	if err != nil {
+		return err
+	}
+	offset, err := arrayOffset(len(v.value), v.changed, len(ss))
+	if err != nil {
+		return err
+	}
+
+	out := make([]netip.AddrPort, len(ss))
+	for i, s := range ss {
+		parsed, err := netip.ParseAddrPort(s)
+		if err != nil {
+			return err
+		}
+		out[i] = parsed
+	}
+
+	if offset == 0 {
+		// the first Set replaces the whole array, e.g. a default one.
+		clear(v.value)
+	}
+	v.changed = offset + copy(v.value[offset:], out)
+	return nil
+}
+
+func (v *addrPortArrayValue) arrayElems() (changed, size int) { return v.changed, len(v.value) }
+
+func (v *addrPortArrayValue) Get() interface{} {
+	if v != nil && v.array.IsValid() {
+		return v.array.Interface()
+	}
+	return nil
+}
+
+func (v *addrPortArrayValue) String() string {
+	if v == nil || !v.array.IsValid() {
+		return "[]"
+	}
+	out := make([]string, 0, len(v.value))
+	for _, elem := range v.value {
+		out = append(out, newAddrPortValue(&elem).String())
+	}
+	return "[" + strings.Join(out, ",") + "]"
+}
+
+func (v *addrPortArrayValue) Type() string { return "addrPortArray" }
+
+func (v *addrPortArrayValue) IsCumulative() bool {
+	return true
+}
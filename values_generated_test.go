@@ -4,6 +4,8 @@ package sflags
 
 import (
 	"net"
+	"net/netip"
+	"net/url"
 	"regexp"
 	"testing"
 	"time"
@@ -167,13 +169,13 @@ func TestStringStringMapValue(t *testing.T) {
 		v := newStringStringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("wrsgrval1")
+		err = v.Set("XVlBzval1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("UZFOx:val1")
+		err = v.Set("gbaiC:val1")
 		assert.Nil(t, err)
-		err = v.Set("HDbppval2")
+		err = v.Set("MRAjWval2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("WeqHO:val2")
+		err = v.Set("whTHc:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]string", v.Type())
@@ -185,9 +187,9 @@ func TestStringStringMapValue(t *testing.T) {
 		v := newStringStringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("OVeUH")
+		err = v.Set("tcuAx")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("kQsaU:")
+		err = v.Set("hxKQF:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]string", v.Type())
@@ -214,13 +216,13 @@ func TestIntStringMapValue(t *testing.T) {
 		v := newIntStringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7val1")
+		err = v.Set("5val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
-		err = v.Set("6:val1")
+		err = v.Set("0:val1")
 		assert.Nil(t, err)
-		err = v.Set("7val2")
+		err = v.Set("3val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
@@ -236,11 +238,11 @@ func TestIntStringMapValue(t *testing.T) {
 		v := newIntStringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7")
+		err = v.Set("5")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("6:")
+		err = v.Set("4:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]string", v.Type())
@@ -255,13 +257,13 @@ func TestInt8StringMapValue(t *testing.T) {
 		v := newInt8StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7val1")
+		err = v.Set("1val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("7:val1")
 		assert.Nil(t, err)
-		err = v.Set("3val2")
+		err = v.Set("5val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
@@ -277,7 +279,7 @@ func TestInt8StringMapValue(t *testing.T) {
 		v := newInt8StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6")
+		err = v.Set("5")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
@@ -296,17 +298,17 @@ func TestInt16StringMapValue(t *testing.T) {
 		v := newInt16StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5val1")
+		err = v.Set("2val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
-		err = v.Set("5:val1")
+		err = v.Set("3:val1")
 		assert.Nil(t, err)
-		err = v.Set("0val2")
+		err = v.Set("1val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
-		err = v.Set("2:val2")
+		err = v.Set("3:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]string", v.Type())
@@ -318,11 +320,11 @@ func TestInt16StringMapValue(t *testing.T) {
 		v := newInt16StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0")
+		err = v.Set("6")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("6:")
+		err = v.Set("4:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]string", v.Type())
@@ -337,17 +339,17 @@ func TestInt32StringMapValue(t *testing.T) {
 		v := newInt32StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1val1")
+		err = v.Set("7val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
-		err = v.Set("6:val1")
+		err = v.Set("1:val1")
 		assert.Nil(t, err)
-		err = v.Set("7val2")
+		err = v.Set("5val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
-		err = v.Set("0:val2")
+		err = v.Set("1:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]string", v.Type())
@@ -359,11 +361,11 @@ func TestInt32StringMapValue(t *testing.T) {
 		v := newInt32StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7")
+		err = v.Set("5")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("0:")
+		err = v.Set("7:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]string", v.Type())
@@ -378,17 +380,17 @@ func TestInt64StringMapValue(t *testing.T) {
 		v := newInt64StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6val1")
+		err = v.Set("0val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
 		err = v.Set("1:val1")
 		assert.Nil(t, err)
-		err = v.Set("3val2")
+		err = v.Set("0val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
-		err = v.Set("0:val2")
+		err = v.Set("2:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]string", v.Type())
@@ -400,11 +402,11 @@ func TestInt64StringMapValue(t *testing.T) {
 		v := newInt64StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5")
+		err = v.Set("7")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("6:")
+		err = v.Set("3:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]string", v.Type())
@@ -419,17 +421,17 @@ func TestUintStringMapValue(t *testing.T) {
 		v := newUintStringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1val1")
+		err = v.Set("3val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
-		err = v.Set("0:val1")
+		err = v.Set("6:val1")
 		assert.Nil(t, err)
-		err = v.Set("0val2")
+		err = v.Set("5val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
-		err = v.Set("5:val2")
+		err = v.Set("4:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]string", v.Type())
@@ -445,7 +447,7 @@ func TestUintStringMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("7:")
+		err = v.Set("4:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]string", v.Type())
@@ -464,13 +466,13 @@ func TestUint8StringMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
-		err = v.Set("7:val1")
+		err = v.Set("2:val1")
 		assert.Nil(t, err)
-		err = v.Set("1val2")
+		err = v.Set("7val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
-		err = v.Set("0:val2")
+		err = v.Set("2:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]string", v.Type())
@@ -482,11 +484,11 @@ func TestUint8StringMapValue(t *testing.T) {
 		v := newUint8StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2")
+		err = v.Set("3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("4:")
+		err = v.Set("0:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]string", v.Type())
@@ -501,13 +503,13 @@ func TestUint16StringMapValue(t *testing.T) {
 		v := newUint16StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5val1")
+		err = v.Set("2val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
-		err = v.Set("4:val1")
+		err = v.Set("6:val1")
 		assert.Nil(t, err)
-		err = v.Set("6val2")
+		err = v.Set("7val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
@@ -523,11 +525,11 @@ func TestUint16StringMapValue(t *testing.T) {
 		v := newUint16StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4")
+		err = v.Set("1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("3:")
+		err = v.Set("7:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]string", v.Type())
@@ -542,17 +544,17 @@ func TestUint32StringMapValue(t *testing.T) {
 		v := newUint32StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1val1")
+		err = v.Set("4val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
-		err = v.Set("5:val1")
+		err = v.Set("4:val1")
 		assert.Nil(t, err)
 		err = v.Set("7val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
-		err = v.Set("2:val2")
+		err = v.Set("1:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]string", v.Type())
@@ -564,11 +566,11 @@ func TestUint32StringMapValue(t *testing.T) {
 		v := newUint32StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6")
+		err = v.Set("1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("7:")
+		err = v.Set("5:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]string", v.Type())
@@ -583,17 +585,17 @@ func TestUint64StringMapValue(t *testing.T) {
 		v := newUint64StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4val1")
+		err = v.Set("1val1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val1")
 		assert.NotNil(t, err)
-		err = v.Set("6:val1")
+		err = v.Set("3:val1")
 		assert.Nil(t, err)
-		err = v.Set("6val2")
+		err = v.Set("1val2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":val2")
 		assert.NotNil(t, err)
-		err = v.Set("2:val2")
+		err = v.Set("3:val2")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]string", v.Type())
@@ -605,11 +607,11 @@ func TestUint64StringMapValue(t *testing.T) {
 		v := newUint64StringMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5")
+		err = v.Set("3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":")
 		assert.NotNil(t, err)
-		err = v.Set("6:")
+		err = v.Set("2:")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]string", v.Type())
@@ -825,13 +827,13 @@ func TestStringBoolMapValue(t *testing.T) {
 		v := newStringBoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("xtPRdtrue")
+		err = v.Set("gmotatrue")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("IgVpT:true")
+		err = v.Set("FetHs:true")
 		assert.Nil(t, err)
-		err = v.Set("UDQDefalse")
+		err = v.Set("bZRjxfalse")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("FleAe:false")
+		err = v.Set("Awnwe:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]bool", v.Type())
@@ -843,9 +845,9 @@ func TestStringBoolMapValue(t *testing.T) {
 		v := newStringBoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("IzEfIunexpected")
+		err = v.Set("krBEmunexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("PLJAS:unexpected")
+		err = v.Set("fdzdc:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]bool", v.Type())
@@ -860,17 +862,17 @@ func TestIntBoolMapValue(t *testing.T) {
 		v := newIntBoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4true")
+		err = v.Set("6true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("7:true")
+		err = v.Set("2:true")
 		assert.Nil(t, err)
-		err = v.Set("1false")
+		err = v.Set("5false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
-		err = v.Set("3:false")
+		err = v.Set("7:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]bool", v.Type())
@@ -886,7 +888,7 @@ func TestIntBoolMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("0:unexpected")
+		err = v.Set("6:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]bool", v.Type())
@@ -901,17 +903,17 @@ func TestInt8BoolMapValue(t *testing.T) {
 		v := newInt8BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7true")
+		err = v.Set("5true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("1:true")
+		err = v.Set("6:true")
 		assert.Nil(t, err)
-		err = v.Set("7false")
+		err = v.Set("3false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
-		err = v.Set("6:false")
+		err = v.Set("5:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]bool", v.Type())
@@ -923,11 +925,11 @@ func TestInt8BoolMapValue(t *testing.T) {
 		v := newInt8BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7unexpected")
+		err = v.Set("4unexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("0:unexpected")
+		err = v.Set("7:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]bool", v.Type())
@@ -942,17 +944,17 @@ func TestInt16BoolMapValue(t *testing.T) {
 		v := newInt16BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2true")
+		err = v.Set("5true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("4:true")
+		err = v.Set("6:true")
 		assert.Nil(t, err)
-		err = v.Set("1false")
+		err = v.Set("3false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
-		err = v.Set("6:false")
+		err = v.Set("5:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]bool", v.Type())
@@ -964,11 +966,11 @@ func TestInt16BoolMapValue(t *testing.T) {
 		v := newInt16BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7unexpected")
+		err = v.Set("0unexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("5:unexpected")
+		err = v.Set("2:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]bool", v.Type())
@@ -983,17 +985,17 @@ func TestInt32BoolMapValue(t *testing.T) {
 		v := newInt32BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2true")
+		err = v.Set("0true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("6:true")
+		err = v.Set("7:true")
 		assert.Nil(t, err)
-		err = v.Set("7false")
+		err = v.Set("0false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
-		err = v.Set("1:false")
+		err = v.Set("3:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]bool", v.Type())
@@ -1005,11 +1007,11 @@ func TestInt32BoolMapValue(t *testing.T) {
 		v := newInt32BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6unexpected")
+		err = v.Set("4unexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("6:unexpected")
+		err = v.Set("4:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]bool", v.Type())
@@ -1024,17 +1026,17 @@ func TestInt64BoolMapValue(t *testing.T) {
 		v := newInt64BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5true")
+		err = v.Set("0true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("3:true")
+		err = v.Set("7:true")
 		assert.Nil(t, err)
 		err = v.Set("4false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
-		err = v.Set("7:false")
+		err = v.Set("2:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]bool", v.Type())
@@ -1046,11 +1048,11 @@ func TestInt64BoolMapValue(t *testing.T) {
 		v := newInt64BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5unexpected")
+		err = v.Set("3unexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("7:unexpected")
+		err = v.Set("4:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]bool", v.Type())
@@ -1069,13 +1071,13 @@ func TestUintBoolMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("2:true")
+		err = v.Set("7:true")
 		assert.Nil(t, err)
-		err = v.Set("3false")
+		err = v.Set("4false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
-		err = v.Set("6:false")
+		err = v.Set("4:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]bool", v.Type())
@@ -1087,11 +1089,11 @@ func TestUintBoolMapValue(t *testing.T) {
 		v := newUintBoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0unexpected")
+		err = v.Set("1unexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("6:unexpected")
+		err = v.Set("7:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]bool", v.Type())
@@ -1106,13 +1108,13 @@ func TestUint8BoolMapValue(t *testing.T) {
 		v := newUint8BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7true")
+		err = v.Set("1true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("6:true")
+		err = v.Set("2:true")
 		assert.Nil(t, err)
-		err = v.Set("1false")
+		err = v.Set("2false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
@@ -1128,11 +1130,11 @@ func TestUint8BoolMapValue(t *testing.T) {
 		v := newUint8BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0unexpected")
+		err = v.Set("7unexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("2:unexpected")
+		err = v.Set("7:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]bool", v.Type())
@@ -1147,13 +1149,13 @@ func TestUint16BoolMapValue(t *testing.T) {
 		v := newUint16BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5true")
+		err = v.Set("2true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("4:true")
+		err = v.Set("2:true")
 		assert.Nil(t, err)
-		err = v.Set("5false")
+		err = v.Set("6false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
@@ -1173,7 +1175,7 @@ func TestUint16BoolMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("6:unexpected")
+		err = v.Set("0:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]bool", v.Type())
@@ -1188,17 +1190,17 @@ func TestUint32BoolMapValue(t *testing.T) {
 		v := newUint32BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1true")
+		err = v.Set("7true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("4:true")
+		err = v.Set("0:true")
 		assert.Nil(t, err)
-		err = v.Set("2false")
+		err = v.Set("6false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
-		err = v.Set("7:false")
+		err = v.Set("6:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]bool", v.Type())
@@ -1210,11 +1212,11 @@ func TestUint32BoolMapValue(t *testing.T) {
 		v := newUint32BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2unexpected")
+		err = v.Set("7unexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("5:unexpected")
+		err = v.Set("0:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]bool", v.Type())
@@ -1229,17 +1231,17 @@ func TestUint64BoolMapValue(t *testing.T) {
 		v := newUint64BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4true")
+		err = v.Set("0true")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":true")
 		assert.NotNil(t, err)
-		err = v.Set("5:true")
+		err = v.Set("2:true")
 		assert.Nil(t, err)
-		err = v.Set("3false")
+		err = v.Set("1false")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":false")
 		assert.NotNil(t, err)
-		err = v.Set("1:false")
+		err = v.Set("7:false")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]bool", v.Type())
@@ -1251,11 +1253,11 @@ func TestUint64BoolMapValue(t *testing.T) {
 		v := newUint64BoolMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6unexpected")
+		err = v.Set("3unexpected")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":unexpected")
 		assert.NotNil(t, err)
-		err = v.Set("3:unexpected")
+		err = v.Set("7:unexpected")
 		assert.EqualError(t, err, "strconv.ParseBool: parsing \"unexpected\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]bool", v.Type())
@@ -1461,13 +1463,13 @@ func TestStringUintMapValue(t *testing.T) {
 		v := newStringUintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("sTxqO10")
+		err = v.Set("sbOJi10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("BnVXN:10")
+		err = v.Set("FQGZs:10")
 		assert.Nil(t, err)
-		err = v.Set("XgzpF20")
+		err = v.Set("nwTKS20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("ogrSY:20")
+		err = v.Set("mVoiG:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint", v.Type())
@@ -1479,9 +1481,9 @@ func TestStringUintMapValue(t *testing.T) {
 		v := newStringUintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("ICxdC-1")
+		err = v.Set("LOpbU-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("gFmOK:-1")
+		err = v.Set("OpEdK:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint", v.Type())
@@ -1496,17 +1498,17 @@ func TestIntUintMapValue(t *testing.T) {
 		v := newIntUintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("7:20")
+		err = v.Set("0:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint", v.Type())
@@ -1518,11 +1520,11 @@ func TestIntUintMapValue(t *testing.T) {
 		v := newIntUintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("0:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint", v.Type())
@@ -1537,17 +1539,17 @@ func TestInt8UintMapValue(t *testing.T) {
 		v := newInt8UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint", v.Type())
@@ -1559,11 +1561,11 @@ func TestInt8UintMapValue(t *testing.T) {
 		v := newInt8UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("3-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("7:-1")
+		err = v.Set("5:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint", v.Type())
@@ -1578,17 +1580,17 @@ func TestInt16UintMapValue(t *testing.T) {
 		v := newInt16UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("1:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint", v.Type())
@@ -1600,11 +1602,11 @@ func TestInt16UintMapValue(t *testing.T) {
 		v := newInt16UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("1:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint", v.Type())
@@ -1619,17 +1621,17 @@ func TestInt32UintMapValue(t *testing.T) {
 		v := newInt32UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010")
+		err = v.Set("210")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("6:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint", v.Type())
@@ -1641,11 +1643,11 @@ func TestInt32UintMapValue(t *testing.T) {
 		v := newInt32UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("2-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("7:-1")
+		err = v.Set("5:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint", v.Type())
@@ -1660,17 +1662,17 @@ func TestInt64UintMapValue(t *testing.T) {
 		v := newInt64UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint", v.Type())
@@ -1682,11 +1684,11 @@ func TestInt64UintMapValue(t *testing.T) {
 		v := newInt64UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1-1")
+		err = v.Set("2-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("4:-1")
+		err = v.Set("0:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint", v.Type())
@@ -1701,17 +1703,17 @@ func TestUintUintMapValue(t *testing.T) {
 		v := newUintUintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("410")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint", v.Type())
@@ -1723,11 +1725,11 @@ func TestUintUintMapValue(t *testing.T) {
 		v := newUintUintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("4-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("6:-1")
+		err = v.Set("0:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint", v.Type())
@@ -1742,17 +1744,17 @@ func TestUint8UintMapValue(t *testing.T) {
 		v := newUint8UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("5:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("1:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint", v.Type())
@@ -1764,7 +1766,7 @@ func TestUint8UintMapValue(t *testing.T) {
 		v := newUint8UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7-1")
+		err = v.Set("4-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
@@ -1783,17 +1785,17 @@ func TestUint16UintMapValue(t *testing.T) {
 		v := newUint16UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("7:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint", v.Type())
@@ -1805,11 +1807,11 @@ func TestUint16UintMapValue(t *testing.T) {
 		v := newUint16UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4-1")
+		err = v.Set("0-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("0:-1")
+		err = v.Set("6:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint", v.Type())
@@ -1824,17 +1826,17 @@ func TestUint32UintMapValue(t *testing.T) {
 		v := newUint32UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010")
+		err = v.Set("410")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("0:10")
 		assert.Nil(t, err)
-		err = v.Set("120")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint", v.Type())
@@ -1846,7 +1848,7 @@ func TestUint32UintMapValue(t *testing.T) {
 		v := newUint32UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("3-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
@@ -1865,17 +1867,17 @@ func TestUint64UintMapValue(t *testing.T) {
 		v := newUint64UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("7:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint", v.Type())
@@ -1887,11 +1889,11 @@ func TestUint64UintMapValue(t *testing.T) {
 		v := newUint64UintMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("1:-1")
+		err = v.Set("0:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint", v.Type())
@@ -2097,13 +2099,13 @@ func TestStringUint8MapValue(t *testing.T) {
 		v := newStringUint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("rGCRh10")
+		err = v.Set("YCOhg10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("GIDKP:10")
+		err = v.Set("HOvgS:10")
 		assert.Nil(t, err)
-		err = v.Set("IgfuE20")
+		err = v.Set("eycJP20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("yvWGn:20")
+		err = v.Set("JHYNu:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint8", v.Type())
@@ -2115,9 +2117,9 @@ func TestStringUint8MapValue(t *testing.T) {
 		v := newStringUint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("vSCQu-1")
+		err = v.Set("fNjJh-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("duvqL:-1")
+		err = v.Set("hjUVR:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint8", v.Type())
@@ -2132,17 +2134,17 @@ func TestIntUint8MapValue(t *testing.T) {
 		v := newIntUint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("0:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("020")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("1:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint8", v.Type())
@@ -2154,11 +2156,11 @@ func TestIntUint8MapValue(t *testing.T) {
 		v := newIntUint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("3:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint8", v.Type())
@@ -2179,11 +2181,11 @@ func TestInt8Uint8MapValue(t *testing.T) {
 		assert.NotNil(t, err)
 		err = v.Set("2:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("6:20")
+		err = v.Set("1:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint8", v.Type())
@@ -2199,7 +2201,7 @@ func TestInt8Uint8MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("7:-1")
+		err = v.Set("3:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint8", v.Type())
@@ -2214,17 +2216,17 @@ func TestInt16Uint8MapValue(t *testing.T) {
 		v := newInt16Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("210")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("5:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint8", v.Type())
@@ -2236,11 +2238,11 @@ func TestInt16Uint8MapValue(t *testing.T) {
 		v := newInt16Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5-1")
+		err = v.Set("3-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("2:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint8", v.Type())
@@ -2255,17 +2257,17 @@ func TestInt32Uint8MapValue(t *testing.T) {
 		v := newInt32Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint8", v.Type())
@@ -2277,11 +2279,11 @@ func TestInt32Uint8MapValue(t *testing.T) {
 		v := newInt32Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5-1")
+		err = v.Set("3-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("7:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint8", v.Type())
@@ -2296,17 +2298,17 @@ func TestInt64Uint8MapValue(t *testing.T) {
 		v := newInt64Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("0:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint8", v.Type())
@@ -2318,11 +2320,11 @@ func TestInt64Uint8MapValue(t *testing.T) {
 		v := newInt64Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7-1")
+		err = v.Set("5-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("2:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint8", v.Type())
@@ -2341,13 +2343,13 @@ func TestUintUint8MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint8", v.Type())
@@ -2359,11 +2361,11 @@ func TestUintUint8MapValue(t *testing.T) {
 		v := newUintUint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7-1")
+		err = v.Set("5-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("7:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint8", v.Type())
@@ -2382,13 +2384,13 @@ func TestUint8Uint8MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("0:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint8", v.Type())
@@ -2400,11 +2402,11 @@ func TestUint8Uint8MapValue(t *testing.T) {
 		v := newUint8Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0-1")
+		err = v.Set("7-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("4:-1")
+		err = v.Set("5:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint8", v.Type())
@@ -2419,17 +2421,17 @@ func TestUint16Uint8MapValue(t *testing.T) {
 		v := newUint16Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("210")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint8", v.Type())
@@ -2441,11 +2443,11 @@ func TestUint16Uint8MapValue(t *testing.T) {
 		v := newUint16Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1-1")
+		err = v.Set("5-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("6:-1")
+		err = v.Set("0:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint8", v.Type())
@@ -2460,17 +2462,17 @@ func TestUint32Uint8MapValue(t *testing.T) {
 		v := newUint32Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("7:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint8", v.Type())
@@ -2482,11 +2484,11 @@ func TestUint32Uint8MapValue(t *testing.T) {
 		v := newUint32Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7-1")
+		err = v.Set("5-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("7:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint8", v.Type())
@@ -2505,13 +2507,13 @@ func TestUint64Uint8MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint8", v.Type())
@@ -2523,11 +2525,11 @@ func TestUint64Uint8MapValue(t *testing.T) {
 		v := newUint64Uint8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7-1")
+		err = v.Set("5-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("1:-1")
+		err = v.Set("3:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint8", v.Type())
@@ -2733,13 +2735,13 @@ func TestStringUint16MapValue(t *testing.T) {
 		v := newStringUint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("woLRx10")
+		err = v.Set("sdjSG10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("qcURU:10")
+		err = v.Set("pngCw:10")
 		assert.Nil(t, err)
-		err = v.Set("ZZJpK20")
+		err = v.Set("FkDif20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("ijFdU:20")
+		err = v.Set("IBuuf:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint16", v.Type())
@@ -2751,9 +2753,9 @@ func TestStringUint16MapValue(t *testing.T) {
 		v := newStringUint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("btVXM-1")
+		err = v.Set("FMoWd-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("UxiKB:-1")
+		err = v.Set("iTskZ:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint16", v.Type())
@@ -2768,17 +2770,17 @@ func TestIntUint16MapValue(t *testing.T) {
 		v := newIntUint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("210")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint16", v.Type())
@@ -2790,11 +2792,11 @@ func TestIntUint16MapValue(t *testing.T) {
 		v := newIntUint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3-1")
+		err = v.Set("4-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("3:-1")
+		err = v.Set("5:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint16", v.Type())
@@ -2813,13 +2815,13 @@ func TestInt8Uint16MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint16", v.Type())
@@ -2831,11 +2833,11 @@ func TestInt8Uint16MapValue(t *testing.T) {
 		v := newInt8Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0-1")
+		err = v.Set("2-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("0:-1")
+		err = v.Set("5:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint16", v.Type())
@@ -2850,17 +2852,17 @@ func TestInt16Uint16MapValue(t *testing.T) {
 		v := newInt16Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("0:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint16", v.Type())
@@ -2872,11 +2874,11 @@ func TestInt16Uint16MapValue(t *testing.T) {
 		v := newInt16Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("4-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("1:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint16", v.Type())
@@ -2891,17 +2893,17 @@ func TestInt32Uint16MapValue(t *testing.T) {
 		v := newInt32Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint16", v.Type())
@@ -2917,7 +2919,7 @@ func TestInt32Uint16MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("1:-1")
+		err = v.Set("6:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint16", v.Type())
@@ -2932,17 +2934,17 @@ func TestInt64Uint16MapValue(t *testing.T) {
 		v := newInt64Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint16", v.Type())
@@ -2954,7 +2956,7 @@ func TestInt64Uint16MapValue(t *testing.T) {
 		v := newInt64Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("1-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
@@ -2973,7 +2975,7 @@ func TestUintUint16MapValue(t *testing.T) {
 		v := newUintUint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
@@ -2995,11 +2997,11 @@ func TestUintUint16MapValue(t *testing.T) {
 		v := newUintUint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("5-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("4:-1")
+		err = v.Set("3:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint16", v.Type())
@@ -3014,17 +3016,17 @@ func TestUint8Uint16MapValue(t *testing.T) {
 		v := newUint8Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("6:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint16", v.Type())
@@ -3036,11 +3038,11 @@ func TestUint8Uint16MapValue(t *testing.T) {
 		v := newUint8Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5-1")
+		err = v.Set("1-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("7:-1")
+		err = v.Set("6:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint16", v.Type())
@@ -3055,17 +3057,17 @@ func TestUint16Uint16MapValue(t *testing.T) {
 		v := newUint16Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint16", v.Type())
@@ -3077,11 +3079,11 @@ func TestUint16Uint16MapValue(t *testing.T) {
 		v := newUint16Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("4-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("6:-1")
+		err = v.Set("5:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint16", v.Type())
@@ -3096,17 +3098,17 @@ func TestUint32Uint16MapValue(t *testing.T) {
 		v := newUint32Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
-		err = v.Set("120")
+		err = v.Set("020")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("1:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint16", v.Type())
@@ -3118,11 +3120,11 @@ func TestUint32Uint16MapValue(t *testing.T) {
 		v := newUint32Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("4:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint16", v.Type())
@@ -3137,17 +3139,17 @@ func TestUint64Uint16MapValue(t *testing.T) {
 		v := newUint64Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint16", v.Type())
@@ -3159,11 +3161,11 @@ func TestUint64Uint16MapValue(t *testing.T) {
 		v := newUint64Uint16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1-1")
+		err = v.Set("3-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("0:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint16", v.Type())
@@ -3369,13 +3371,13 @@ func TestStringUint32MapValue(t *testing.T) {
 		v := newStringUint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("jUIFq10")
+		err = v.Set("VgzHb10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("uqvCQ:10")
+		err = v.Set("lmYYt:10")
 		assert.Nil(t, err)
-		err = v.Set("JgddE20")
+		err = v.Set("EjVgw20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("NTETe:20")
+		err = v.Set("fFbbG:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint32", v.Type())
@@ -3387,9 +3389,9 @@ func TestStringUint32MapValue(t *testing.T) {
 		v := newStringUint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("GohSC-1")
+		err = v.Set("Gcnqb-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("Nhcim:-1")
+		err = v.Set("aEREu:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint32", v.Type())
@@ -3404,17 +3406,17 @@ func TestIntUint32MapValue(t *testing.T) {
 		v := newIntUint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint32", v.Type())
@@ -3426,11 +3428,11 @@ func TestIntUint32MapValue(t *testing.T) {
 		v := newIntUint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("5:-1")
+		err = v.Set("1:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint32", v.Type())
@@ -3445,17 +3447,17 @@ func TestInt8Uint32MapValue(t *testing.T) {
 		v := newInt8Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("410")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("1:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint32", v.Type())
@@ -3467,11 +3469,11 @@ func TestInt8Uint32MapValue(t *testing.T) {
 		v := newInt8Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4-1")
+		err = v.Set("0-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("3:-1")
+		err = v.Set("7:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint32", v.Type())
@@ -3486,17 +3488,17 @@ func TestInt16Uint32MapValue(t *testing.T) {
 		v := newInt16Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("1:20")
+		err = v.Set("0:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint32", v.Type())
@@ -3508,11 +3510,11 @@ func TestInt16Uint32MapValue(t *testing.T) {
 		v := newInt16Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("2-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("5:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint32", v.Type())
@@ -3527,17 +3529,17 @@ func TestInt32Uint32MapValue(t *testing.T) {
 		v := newInt32Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint32", v.Type())
@@ -3549,11 +3551,11 @@ func TestInt32Uint32MapValue(t *testing.T) {
 		v := newInt32Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5-1")
+		err = v.Set("3-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("6:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint32", v.Type())
@@ -3568,17 +3570,17 @@ func TestInt64Uint32MapValue(t *testing.T) {
 		v := newInt64Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("5:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint32", v.Type())
@@ -3590,11 +3592,11 @@ func TestInt64Uint32MapValue(t *testing.T) {
 		v := newInt64Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("7-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("1:-1")
+		err = v.Set("6:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint32", v.Type())
@@ -3609,17 +3611,17 @@ func TestUintUint32MapValue(t *testing.T) {
 		v := newUintUint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint32", v.Type())
@@ -3631,11 +3633,11 @@ func TestUintUint32MapValue(t *testing.T) {
 		v := newUintUint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("5-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("1:-1")
+		err = v.Set("0:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint32", v.Type())
@@ -3650,17 +3652,17 @@ func TestUint8Uint32MapValue(t *testing.T) {
 		v := newUint8Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("0:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("020")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint32", v.Type())
@@ -3672,11 +3674,11 @@ func TestUint8Uint32MapValue(t *testing.T) {
 		v := newUint8Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("6:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint32", v.Type())
@@ -3691,17 +3693,17 @@ func TestUint16Uint32MapValue(t *testing.T) {
 		v := newUint16Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint32", v.Type())
@@ -3713,11 +3715,11 @@ func TestUint16Uint32MapValue(t *testing.T) {
 		v := newUint16Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0-1")
+		err = v.Set("3-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("3:-1")
+		err = v.Set("5:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint32", v.Type())
@@ -3732,11 +3734,11 @@ func TestUint32Uint32MapValue(t *testing.T) {
 		v := newUint32Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
 		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
@@ -3754,11 +3756,11 @@ func TestUint32Uint32MapValue(t *testing.T) {
 		v := newUint32Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("3-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("2:-1")
+		err = v.Set("3:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint32", v.Type())
@@ -3773,17 +3775,17 @@ func TestUint64Uint32MapValue(t *testing.T) {
 		v := newUint64Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint32", v.Type())
@@ -3795,11 +3797,11 @@ func TestUint64Uint32MapValue(t *testing.T) {
 		v := newUint64Uint32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1-1")
+		err = v.Set("0-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("4:-1")
+		err = v.Set("0:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint32", v.Type())
@@ -4005,13 +4007,13 @@ func TestStringUint64MapValue(t *testing.T) {
 		v := newStringUint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("WicBB10")
+		err = v.Set("Lpruc10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("DDYhz:10")
+		err = v.Set("jiOgj:10")
 		assert.Nil(t, err)
-		err = v.Set("Wowlk20")
+		err = v.Set("hYeVw20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("HyCsK:20")
+		err = v.Set("BTCML:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint64", v.Type())
@@ -4023,9 +4025,9 @@ func TestStringUint64MapValue(t *testing.T) {
 		v := newStringUint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("bldSl-1")
+		err = v.Set("frDGX-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("htflf:-1")
+		err = v.Set("qwpzw:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]uint64", v.Type())
@@ -4040,17 +4042,17 @@ func TestIntUint64MapValue(t *testing.T) {
 		v := newIntUint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]uint64", v.Type())
@@ -4062,7 +4064,7 @@ func TestIntUint64MapValue(t *testing.T) {
 		v := newIntUint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6-1")
+		err = v.Set("7-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
@@ -4081,17 +4083,17 @@ func TestInt8Uint64MapValue(t *testing.T) {
 		v := newInt8Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint64", v.Type())
@@ -4103,11 +4105,11 @@ func TestInt8Uint64MapValue(t *testing.T) {
 		v := newInt8Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5-1")
+		err = v.Set("4-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("0:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]uint64", v.Type())
@@ -4122,17 +4124,17 @@ func TestInt16Uint64MapValue(t *testing.T) {
 		v := newInt16Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint64", v.Type())
@@ -4144,11 +4146,11 @@ func TestInt16Uint64MapValue(t *testing.T) {
 		v := newInt16Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("3:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]uint64", v.Type())
@@ -4163,17 +4165,17 @@ func TestInt32Uint64MapValue(t *testing.T) {
 		v := newInt32Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint64", v.Type())
@@ -4185,11 +4187,11 @@ func TestInt32Uint64MapValue(t *testing.T) {
 		v := newInt32Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0-1")
+		err = v.Set("2-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("1:-1")
+		err = v.Set("6:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]uint64", v.Type())
@@ -4208,13 +4210,13 @@ func TestInt64Uint64MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint64", v.Type())
@@ -4226,11 +4228,11 @@ func TestInt64Uint64MapValue(t *testing.T) {
 		v := newInt64Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("1-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("7:-1")
+		err = v.Set("0:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]uint64", v.Type())
@@ -4245,11 +4247,11 @@ func TestUintUint64MapValue(t *testing.T) {
 		v := newUintUint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("210")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
@@ -4267,11 +4269,11 @@ func TestUintUint64MapValue(t *testing.T) {
 		v := newUintUint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("1-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("6:-1")
+		err = v.Set("5:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]uint64", v.Type())
@@ -4286,17 +4288,17 @@ func TestUint8Uint64MapValue(t *testing.T) {
 		v := newUint8Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("1:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint64", v.Type())
@@ -4308,11 +4310,11 @@ func TestUint8Uint64MapValue(t *testing.T) {
 		v := newUint8Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4-1")
+		err = v.Set("1-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("3:-1")
+		err = v.Set("2:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]uint64", v.Type())
@@ -4327,17 +4329,17 @@ func TestUint16Uint64MapValue(t *testing.T) {
 		v := newUint16Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("1:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint64", v.Type())
@@ -4349,11 +4351,11 @@ func TestUint16Uint64MapValue(t *testing.T) {
 		v := newUint16Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("6-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("3:-1")
+		err = v.Set("0:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]uint64", v.Type())
@@ -4368,11 +4370,11 @@ func TestUint32Uint64MapValue(t *testing.T) {
 		v := newUint32Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
@@ -4390,11 +4392,11 @@ func TestUint32Uint64MapValue(t *testing.T) {
 		v := newUint32Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0-1")
+		err = v.Set("4-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("0:-1")
+		err = v.Set("1:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]uint64", v.Type())
@@ -4409,17 +4411,17 @@ func TestUint64Uint64MapValue(t *testing.T) {
 		v := newUint64Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("210")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("5:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint64", v.Type())
@@ -4431,11 +4433,11 @@ func TestUint64Uint64MapValue(t *testing.T) {
 		v := newUint64Uint64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2-1")
+		err = v.Set("1-1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":-1")
 		assert.NotNil(t, err)
-		err = v.Set("0:-1")
+		err = v.Set("4:-1")
 		assert.EqualError(t, err, "strconv.ParseUint: parsing \"-1\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]uint64", v.Type())
@@ -4681,13 +4683,13 @@ func TestStringIntMapValue(t *testing.T) {
 		v := newStringIntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("NPHew10")
+		err = v.Set("aiIsN10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("SEayQ:10")
+		err = v.Set("BakqS:10")
 		assert.Nil(t, err)
-		err = v.Set("Hqbxo20")
+		err = v.Set("wQpOQ20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("VwBuK:20")
+		err = v.Set("gNczg:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int", v.Type())
@@ -4699,9 +4701,9 @@ func TestStringIntMapValue(t *testing.T) {
 		v := newStringIntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("BwmzEa")
+		err = v.Set("aczAIa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("lpPUx:a")
+		err = v.Set("nLqLI:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int", v.Type())
@@ -4716,17 +4718,17 @@ func TestIntIntMapValue(t *testing.T) {
 		v := newIntIntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("7:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int", v.Type())
@@ -4738,11 +4740,11 @@ func TestIntIntMapValue(t *testing.T) {
 		v := newIntIntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("1a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("7:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int", v.Type())
@@ -4757,17 +4759,17 @@ func TestInt8IntMapValue(t *testing.T) {
 		v := newInt8IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int", v.Type())
@@ -4779,11 +4781,11 @@ func TestInt8IntMapValue(t *testing.T) {
 		v := newInt8IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("0:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int", v.Type())
@@ -4798,17 +4800,17 @@ func TestInt16IntMapValue(t *testing.T) {
 		v := newInt16IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("020")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int", v.Type())
@@ -4820,11 +4822,11 @@ func TestInt16IntMapValue(t *testing.T) {
 		v := newInt16IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("5:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int", v.Type())
@@ -4843,13 +4845,13 @@ func TestInt32IntMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int", v.Type())
@@ -4861,11 +4863,11 @@ func TestInt32IntMapValue(t *testing.T) {
 		v := newInt32IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("3:a")
+		err = v.Set("5:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int", v.Type())
@@ -4880,13 +4882,13 @@ func TestInt64IntMapValue(t *testing.T) {
 		v := newInt64IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
@@ -4902,11 +4904,11 @@ func TestInt64IntMapValue(t *testing.T) {
 		v := newInt64IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int", v.Type())
@@ -4921,17 +4923,17 @@ func TestUintIntMapValue(t *testing.T) {
 		v := newUintIntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("6:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int", v.Type())
@@ -4943,7 +4945,7 @@ func TestUintIntMapValue(t *testing.T) {
 		v := newUintIntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("7a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
@@ -4962,17 +4964,17 @@ func TestUint8IntMapValue(t *testing.T) {
 		v := newUint8IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010")
+		err = v.Set("410")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("7:20")
+		err = v.Set("1:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int", v.Type())
@@ -4984,11 +4986,11 @@ func TestUint8IntMapValue(t *testing.T) {
 		v := newUint8IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3a")
+		err = v.Set("7a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("7:a")
+		err = v.Set("0:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int", v.Type())
@@ -5003,17 +5005,17 @@ func TestUint16IntMapValue(t *testing.T) {
 		v := newUint16IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("210")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int", v.Type())
@@ -5025,7 +5027,7 @@ func TestUint16IntMapValue(t *testing.T) {
 		v := newUint16IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("7a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
@@ -5044,11 +5046,11 @@ func TestUint32IntMapValue(t *testing.T) {
 		v := newUint32IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("0:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
@@ -5066,11 +5068,11 @@ func TestUint32IntMapValue(t *testing.T) {
 		v := newUint32IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("4a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int", v.Type())
@@ -5085,13 +5087,13 @@ func TestUint64IntMapValue(t *testing.T) {
 		v := newUint64IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
@@ -5107,7 +5109,7 @@ func TestUint64IntMapValue(t *testing.T) {
 		v := newUint64IntMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
@@ -5327,13 +5329,13 @@ func TestStringInt8MapValue(t *testing.T) {
 		v := newStringInt8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("BYltm10")
+		err = v.Set("eBZBF10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("RUAjb:10")
+		err = v.Set("elhXk:10")
 		assert.Nil(t, err)
-		err = v.Set("vPJHu20")
+		err = v.Set("zzfNa20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("iGlkH:20")
+		err = v.Set("VtAyy:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int8", v.Type())
@@ -5345,9 +5347,9 @@ func TestStringInt8MapValue(t *testing.T) {
 		v := newStringInt8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("Xwgxqa")
+		err = v.Set("qWzKqa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("kOVvs:a")
+		err = v.Set("QFbuc:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int8", v.Type())
@@ -5362,17 +5364,17 @@ func TestIntInt8MapValue(t *testing.T) {
 		v := newIntInt8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int8", v.Type())
@@ -5388,7 +5390,7 @@ func TestIntInt8MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int8", v.Type())
@@ -5403,17 +5405,17 @@ func TestInt8Int8MapValue(t *testing.T) {
 		v := newInt8Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
 		err = v.Set("020")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("6:20")
+		err = v.Set("0:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int8", v.Type())
@@ -5425,11 +5427,11 @@ func TestInt8Int8MapValue(t *testing.T) {
 		v := newInt8Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2a")
+		err = v.Set("4a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("5:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int8", v.Type())
@@ -5444,17 +5446,17 @@ func TestInt16Int8MapValue(t *testing.T) {
 		v := newInt16Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int8", v.Type())
@@ -5466,11 +5468,11 @@ func TestInt16Int8MapValue(t *testing.T) {
 		v := newInt16Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("4a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("0:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int8", v.Type())
@@ -5485,17 +5487,17 @@ func TestInt32Int8MapValue(t *testing.T) {
 		v := newInt32Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int8", v.Type())
@@ -5507,11 +5509,11 @@ func TestInt32Int8MapValue(t *testing.T) {
 		v := newInt32Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("3:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int8", v.Type())
@@ -5526,17 +5528,17 @@ func TestInt64Int8MapValue(t *testing.T) {
 		v := newInt64Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
 		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int8", v.Type())
@@ -5548,11 +5550,11 @@ func TestInt64Int8MapValue(t *testing.T) {
 		v := newInt64Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("0:a")
+		err = v.Set("4:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int8", v.Type())
@@ -5571,9 +5573,9 @@ func TestUintInt8MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("020")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
@@ -5589,11 +5591,11 @@ func TestUintInt8MapValue(t *testing.T) {
 		v := newUintInt8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("0:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int8", v.Type())
@@ -5608,17 +5610,17 @@ func TestUint8Int8MapValue(t *testing.T) {
 		v := newUint8Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int8", v.Type())
@@ -5630,11 +5632,11 @@ func TestUint8Int8MapValue(t *testing.T) {
 		v := newUint8Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("3:a")
+		err = v.Set("1:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int8", v.Type())
@@ -5649,13 +5651,13 @@ func TestUint16Int8MapValue(t *testing.T) {
 		v := newUint16Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
@@ -5675,7 +5677,7 @@ func TestUint16Int8MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("1:a")
+		err = v.Set("7:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int8", v.Type())
@@ -5690,17 +5692,17 @@ func TestUint32Int8MapValue(t *testing.T) {
 		v := newUint32Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("6:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int8", v.Type())
@@ -5712,11 +5714,11 @@ func TestUint32Int8MapValue(t *testing.T) {
 		v := newUint32Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("3:a")
+		err = v.Set("5:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int8", v.Type())
@@ -5731,17 +5733,17 @@ func TestUint64Int8MapValue(t *testing.T) {
 		v := newUint64Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int8", v.Type())
@@ -5753,11 +5755,11 @@ func TestUint64Int8MapValue(t *testing.T) {
 		v := newUint64Int8MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("1:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int8", v.Type())
@@ -5973,13 +5975,13 @@ func TestStringInt16MapValue(t *testing.T) {
 		v := newStringInt16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("diTxK10")
+		err = v.Set("qcnCT10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("jdFiy:10")
+		err = v.Set("uGZam:10")
 		assert.Nil(t, err)
-		err = v.Set("UBdKs20")
+		err = v.Set("CToZv20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("JLnio:20")
+		err = v.Set("PynaE:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int16", v.Type())
@@ -5991,9 +5993,9 @@ func TestStringInt16MapValue(t *testing.T) {
 		v := newStringInt16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("GdqnGa")
+		err = v.Set("phIdXa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("bFwrk:a")
+		err = v.Set("aKUaq:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int16", v.Type())
@@ -6008,17 +6010,17 @@ func TestIntInt16MapValue(t *testing.T) {
 		v := newIntInt16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("120")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int16", v.Type())
@@ -6034,7 +6036,7 @@ func TestIntInt16MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("7:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int16", v.Type())
@@ -6049,17 +6051,17 @@ func TestInt8Int16MapValue(t *testing.T) {
 		v := newInt8Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("1:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int16", v.Type())
@@ -6071,11 +6073,11 @@ func TestInt8Int16MapValue(t *testing.T) {
 		v := newInt8Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("5:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int16", v.Type())
@@ -6090,17 +6092,17 @@ func TestInt16Int16MapValue(t *testing.T) {
 		v := newInt16Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
 		err = v.Set("120")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("0:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int16", v.Type())
@@ -6112,11 +6114,11 @@ func TestInt16Int16MapValue(t *testing.T) {
 		v := newInt16Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("0:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int16", v.Type())
@@ -6131,17 +6133,17 @@ func TestInt32Int16MapValue(t *testing.T) {
 		v := newInt32Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("1:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int16", v.Type())
@@ -6153,11 +6155,11 @@ func TestInt32Int16MapValue(t *testing.T) {
 		v := newInt32Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("7:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int16", v.Type())
@@ -6172,17 +6174,17 @@ func TestInt64Int16MapValue(t *testing.T) {
 		v := newInt64Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("6:20")
+		err = v.Set("0:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int16", v.Type())
@@ -6194,11 +6196,11 @@ func TestInt64Int16MapValue(t *testing.T) {
 		v := newInt64Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4a")
+		err = v.Set("2a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("1:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int16", v.Type())
@@ -6213,17 +6215,17 @@ func TestUintInt16MapValue(t *testing.T) {
 		v := newUintInt16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("1:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int16", v.Type())
@@ -6235,11 +6237,11 @@ func TestUintInt16MapValue(t *testing.T) {
 		v := newUintInt16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("3:a")
+		err = v.Set("1:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int16", v.Type())
@@ -6254,17 +6256,17 @@ func TestUint8Int16MapValue(t *testing.T) {
 		v := newUint8Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("7:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int16", v.Type())
@@ -6276,11 +6278,11 @@ func TestUint8Int16MapValue(t *testing.T) {
 		v := newUint8Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("1a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("0:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int16", v.Type())
@@ -6295,17 +6297,17 @@ func TestUint16Int16MapValue(t *testing.T) {
 		v := newUint16Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("410")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int16", v.Type())
@@ -6317,11 +6319,11 @@ func TestUint16Int16MapValue(t *testing.T) {
 		v := newUint16Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2a")
+		err = v.Set("1a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("5:a")
+		err = v.Set("1:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int16", v.Type())
@@ -6336,17 +6338,17 @@ func TestUint32Int16MapValue(t *testing.T) {
 		v := newUint32Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("5:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int16", v.Type())
@@ -6362,7 +6364,7 @@ func TestUint32Int16MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int16", v.Type())
@@ -6377,17 +6379,17 @@ func TestUint64Int16MapValue(t *testing.T) {
 		v := newUint64Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
 		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("1:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int16", v.Type())
@@ -6399,11 +6401,11 @@ func TestUint64Int16MapValue(t *testing.T) {
 		v := newUint64Int16MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3a")
+		err = v.Set("2a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("7:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int16", v.Type())
@@ -6619,13 +6621,13 @@ func TestStringInt32MapValue(t *testing.T) {
 		v := newStringInt32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("qYWYf10")
+		err = v.Set("hGCMd10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("QTpWU:10")
+		err = v.Set("fLitT:10")
 		assert.Nil(t, err)
-		err = v.Set("YLbbO20")
+		err = v.Set("qwLUe20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("NSkYj:20")
+		err = v.Set("cgOcz:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int32", v.Type())
@@ -6637,9 +6639,9 @@ func TestStringInt32MapValue(t *testing.T) {
 		v := newStringInt32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("NxWVva")
+		err = v.Set("XTbRMa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("qGPsu:a")
+		err = v.Set("GxqPe:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int32", v.Type())
@@ -6654,17 +6656,17 @@ func TestIntInt32MapValue(t *testing.T) {
 		v := newIntInt32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int32", v.Type())
@@ -6676,11 +6678,11 @@ func TestIntInt32MapValue(t *testing.T) {
 		v := newIntInt32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int32", v.Type())
@@ -6701,11 +6703,11 @@ func TestInt8Int32MapValue(t *testing.T) {
 		assert.NotNil(t, err)
 		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("7:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int32", v.Type())
@@ -6717,11 +6719,11 @@ func TestInt8Int32MapValue(t *testing.T) {
 		v := newInt8Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4a")
+		err = v.Set("1a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("1:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int32", v.Type())
@@ -6740,13 +6742,13 @@ func TestInt16Int32MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int32", v.Type())
@@ -6758,7 +6760,7 @@ func TestInt16Int32MapValue(t *testing.T) {
 		v := newInt16Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
@@ -6777,13 +6779,13 @@ func TestInt32Int32MapValue(t *testing.T) {
 		v := newInt32Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("320")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
@@ -6799,11 +6801,11 @@ func TestInt32Int32MapValue(t *testing.T) {
 		v := newInt32Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("7a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("7:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int32", v.Type())
@@ -6818,17 +6820,17 @@ func TestInt64Int32MapValue(t *testing.T) {
 		v := newInt64Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("710")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("5:10")
 		assert.Nil(t, err)
 		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int32", v.Type())
@@ -6840,11 +6842,11 @@ func TestInt64Int32MapValue(t *testing.T) {
 		v := newInt64Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3a")
+		err = v.Set("6a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("1:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int32", v.Type())
@@ -6859,13 +6861,13 @@ func TestUintInt32MapValue(t *testing.T) {
 		v := newUintInt32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("510")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
@@ -6885,7 +6887,7 @@ func TestUintInt32MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("4:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int32", v.Type())
@@ -6900,17 +6902,17 @@ func TestUint8Int32MapValue(t *testing.T) {
 		v := newUint8Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("210")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("5:10")
 		assert.Nil(t, err)
-		err = v.Set("120")
+		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("6:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int32", v.Type())
@@ -6922,11 +6924,11 @@ func TestUint8Int32MapValue(t *testing.T) {
 		v := newUint8Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("1a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("4:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int32", v.Type())
@@ -6941,13 +6943,13 @@ func TestUint16Int32MapValue(t *testing.T) {
 		v := newUint16Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("0:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
@@ -6963,11 +6965,11 @@ func TestUint16Int32MapValue(t *testing.T) {
 		v := newUint16Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2a")
+		err = v.Set("4a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("3:a")
+		err = v.Set("1:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int32", v.Type())
@@ -6982,17 +6984,17 @@ func TestUint32Int32MapValue(t *testing.T) {
 		v := newUint32Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int32", v.Type())
@@ -7004,11 +7006,11 @@ func TestUint32Int32MapValue(t *testing.T) {
 		v := newUint32Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3a")
+		err = v.Set("6a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("1:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int32", v.Type())
@@ -7023,17 +7025,17 @@ func TestUint64Int32MapValue(t *testing.T) {
 		v := newUint64Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("3:10")
+		err = v.Set("5:10")
 		assert.Nil(t, err)
-		err = v.Set("420")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int32", v.Type())
@@ -7045,11 +7047,11 @@ func TestUint64Int32MapValue(t *testing.T) {
 		v := newUint64Int32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("5:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int32", v.Type())
@@ -7265,13 +7267,13 @@ func TestStringInt64MapValue(t *testing.T) {
 		v := newStringInt64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("DiYmt10")
+		err = v.Set("ORvAU10")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("OkaWm:10")
+		err = v.Set("kAwww:10")
 		assert.Nil(t, err)
-		err = v.Set("zQWYI20")
+		err = v.Set("TndUJ20")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("SWiwW:20")
+		err = v.Set("HiQec:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int64", v.Type())
@@ -7283,9 +7285,9 @@ func TestStringInt64MapValue(t *testing.T) {
 		v := newStringInt64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("wMsGxa")
+		err = v.Set("bxzvqa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("CEOlY:a")
+		err = v.Set("zlPWy:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]int64", v.Type())
@@ -7300,17 +7302,17 @@ func TestIntInt64MapValue(t *testing.T) {
 		v := newIntInt64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010")
+		err = v.Set("410")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("5:20")
+		err = v.Set("2:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int64", v.Type())
@@ -7322,11 +7324,11 @@ func TestIntInt64MapValue(t *testing.T) {
 		v := newIntInt64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("0:a")
+		err = v.Set("1:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]int64", v.Type())
@@ -7341,17 +7343,17 @@ func TestInt8Int64MapValue(t *testing.T) {
 		v := newInt8Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("6:10")
 		assert.Nil(t, err)
-		err = v.Set("020")
+		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int64", v.Type())
@@ -7363,11 +7365,11 @@ func TestInt8Int64MapValue(t *testing.T) {
 		v := newInt8Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2a")
+		err = v.Set("7a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("7:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]int64", v.Type())
@@ -7382,17 +7384,17 @@ func TestInt16Int64MapValue(t *testing.T) {
 		v := newInt16Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610")
+		err = v.Set("310")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
 		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("0:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int64", v.Type())
@@ -7404,11 +7406,11 @@ func TestInt16Int64MapValue(t *testing.T) {
 		v := newInt16Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("4a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("5:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]int64", v.Type())
@@ -7423,17 +7425,17 @@ func TestInt32Int64MapValue(t *testing.T) {
 		v := newInt32Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("4:10")
+		err = v.Set("2:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("7:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int64", v.Type())
@@ -7445,11 +7447,11 @@ func TestInt32Int64MapValue(t *testing.T) {
 		v := newInt32Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("1:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]int64", v.Type())
@@ -7464,17 +7466,17 @@ func TestInt64Int64MapValue(t *testing.T) {
 		v := newInt64Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("410")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("7:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("720")
+		err = v.Set("420")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("3:20")
+		err = v.Set("4:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int64", v.Type())
@@ -7486,11 +7488,11 @@ func TestInt64Int64MapValue(t *testing.T) {
 		v := newInt64Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("1:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]int64", v.Type())
@@ -7505,17 +7507,17 @@ func TestUintInt64MapValue(t *testing.T) {
 		v := newUintInt64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410")
+		err = v.Set("110")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("5:10")
+		err = v.Set("4:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("320")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("5:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int64", v.Type())
@@ -7527,11 +7529,11 @@ func TestUintInt64MapValue(t *testing.T) {
 		v := newUintInt64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3a")
+		err = v.Set("6a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("5:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]int64", v.Type())
@@ -7546,13 +7548,13 @@ func TestUint8Int64MapValue(t *testing.T) {
 		v := newUint8Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("0:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("220")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
@@ -7568,11 +7570,11 @@ func TestUint8Int64MapValue(t *testing.T) {
 		v := newUint8Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("5:a")
+		err = v.Set("7:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]int64", v.Type())
@@ -7587,17 +7589,17 @@ func TestUint16Int64MapValue(t *testing.T) {
 		v := newUint16Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("410")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("2:10")
+		err = v.Set("1:10")
 		assert.Nil(t, err)
-		err = v.Set("520")
+		err = v.Set("720")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("2:20")
+		err = v.Set("6:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int64", v.Type())
@@ -7613,7 +7615,7 @@ func TestUint16Int64MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]int64", v.Type())
@@ -7628,17 +7630,17 @@ func TestUint32Int64MapValue(t *testing.T) {
 		v := newUint32Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710")
+		err = v.Set("610")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("3:10")
 		assert.Nil(t, err)
-		err = v.Set("620")
+		err = v.Set("520")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("5:20")
+		err = v.Set("1:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int64", v.Type())
@@ -7650,11 +7652,11 @@ func TestUint32Int64MapValue(t *testing.T) {
 		v := newUint32Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("2a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]int64", v.Type())
@@ -7669,17 +7671,17 @@ func TestUint64Int64MapValue(t *testing.T) {
 		v := newUint64Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510")
+		err = v.Set("010")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10")
 		assert.NotNil(t, err)
-		err = v.Set("6:10")
+		err = v.Set("7:10")
 		assert.Nil(t, err)
-		err = v.Set("220")
+		err = v.Set("620")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20")
 		assert.NotNil(t, err)
-		err = v.Set("4:20")
+		err = v.Set("3:20")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int64", v.Type())
@@ -7691,11 +7693,11 @@ func TestUint64Int64MapValue(t *testing.T) {
 		v := newUint64Int64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("5:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseInt: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]int64", v.Type())
@@ -7891,13 +7893,13 @@ func TestStringFloat64MapValue(t *testing.T) {
 		v := newStringFloat64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("YDZQL10.2")
+		err = v.Set("hTtUT10.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("RoakS:10.2")
+		err = v.Set("uWJRG:10.2")
 		assert.Nil(t, err)
-		err = v.Set("sGcUw20.99")
+		err = v.Set("iQjOT20.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("BnTRE:20.99")
+		err = v.Set("cVwHj:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]float64", v.Type())
@@ -7909,9 +7911,9 @@ func TestStringFloat64MapValue(t *testing.T) {
 		v := newStringFloat64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("pRWBNa")
+		err = v.Set("KVtPoa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("GhdOA:a")
+		err = v.Set("VVTYN:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]float64", v.Type())
@@ -7926,17 +7928,17 @@ func TestIntFloat64MapValue(t *testing.T) {
 		v := newIntFloat64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610.2")
+		err = v.Set("110.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("0:10.2")
+		err = v.Set("5:10.2")
 		assert.Nil(t, err)
-		err = v.Set("420.99")
+		err = v.Set("520.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("4:20.99")
+		err = v.Set("5:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]float64", v.Type())
@@ -7948,11 +7950,11 @@ func TestIntFloat64MapValue(t *testing.T) {
 		v := newIntFloat64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("1a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]float64", v.Type())
@@ -7967,13 +7969,13 @@ func TestInt8Float64MapValue(t *testing.T) {
 		v := newInt8Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410.2")
+		err = v.Set("510.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("2:10.2")
+		err = v.Set("0:10.2")
 		assert.Nil(t, err)
-		err = v.Set("420.99")
+		err = v.Set("620.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
@@ -7989,11 +7991,11 @@ func TestInt8Float64MapValue(t *testing.T) {
 		v := newInt8Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("7:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]float64", v.Type())
@@ -8008,17 +8010,17 @@ func TestInt16Float64MapValue(t *testing.T) {
 		v := newInt16Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510.2")
+		err = v.Set("710.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("5:10.2")
+		err = v.Set("0:10.2")
 		assert.Nil(t, err)
-		err = v.Set("420.99")
+		err = v.Set("520.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("7:20.99")
+		err = v.Set("1:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]float64", v.Type())
@@ -8030,11 +8032,11 @@ func TestInt16Float64MapValue(t *testing.T) {
 		v := newInt16Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("3:a")
+		err = v.Set("0:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]float64", v.Type())
@@ -8049,17 +8051,17 @@ func TestInt32Float64MapValue(t *testing.T) {
 		v := newInt32Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210.2")
+		err = v.Set("510.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("1:10.2")
+		err = v.Set("2:10.2")
 		assert.Nil(t, err)
-		err = v.Set("620.99")
+		err = v.Set("220.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("5:20.99")
+		err = v.Set("4:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]float64", v.Type())
@@ -8071,7 +8073,7 @@ func TestInt32Float64MapValue(t *testing.T) {
 		v := newInt32Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
@@ -8090,17 +8092,17 @@ func TestInt64Float64MapValue(t *testing.T) {
 		v := newInt64Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710.2")
+		err = v.Set("310.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("5:10.2")
+		err = v.Set("3:10.2")
 		assert.Nil(t, err)
-		err = v.Set("220.99")
+		err = v.Set("420.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("1:20.99")
+		err = v.Set("3:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]float64", v.Type())
@@ -8116,7 +8118,7 @@ func TestInt64Float64MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("5:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]float64", v.Type())
@@ -8135,13 +8137,13 @@ func TestUintFloat64MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("0:10.2")
+		err = v.Set("7:10.2")
 		assert.Nil(t, err)
-		err = v.Set("420.99")
+		err = v.Set("620.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("7:20.99")
+		err = v.Set("3:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]float64", v.Type())
@@ -8157,7 +8159,7 @@ func TestUintFloat64MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("0:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]float64", v.Type())
@@ -8172,17 +8174,17 @@ func TestUint8Float64MapValue(t *testing.T) {
 		v := newUint8Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610.2")
+		err = v.Set("210.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("6:10.2")
+		err = v.Set("5:10.2")
 		assert.Nil(t, err)
-		err = v.Set("720.99")
+		err = v.Set("120.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("5:20.99")
+		err = v.Set("2:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]float64", v.Type())
@@ -8194,7 +8196,7 @@ func TestUint8Float64MapValue(t *testing.T) {
 		v := newUint8Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
@@ -8217,13 +8219,13 @@ func TestUint16Float64MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("4:10.2")
+		err = v.Set("2:10.2")
 		assert.Nil(t, err)
-		err = v.Set("320.99")
+		err = v.Set("420.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("6:20.99")
+		err = v.Set("7:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]float64", v.Type())
@@ -8235,11 +8237,11 @@ func TestUint16Float64MapValue(t *testing.T) {
 		v := newUint16Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2a")
+		err = v.Set("4a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("7:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]float64", v.Type())
@@ -8254,13 +8256,13 @@ func TestUint32Float64MapValue(t *testing.T) {
 		v := newUint32Float64MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510.2")
+		err = v.Set("710.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("2:10.2")
+		err = v.Set("6:10.2")
 		assert.Nil(t, err)
-		err = v.Set("020.99")
+		err = v.Set("420.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
@@ -8280,7 +8282,7 @@ func TestUint32Float64MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("4:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]float64", v.Type())
@@ -8299,13 +8301,13 @@ func TestUint64Float64MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("4:10.2")
+		err = v.Set("6:10.2")
 		assert.Nil(t, err)
-		err = v.Set("720.99")
+		err = v.Set("420.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("5:20.99")
+		err = v.Set("4:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]float64", v.Type())
@@ -8517,13 +8519,13 @@ func TestStringFloat32MapValue(t *testing.T) {
 		v := newStringFloat32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("BDLrL10.2")
+		err = v.Set("aWlfV10.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("eXCff:10.2")
+		err = v.Set("cjYMc:10.2")
 		assert.Nil(t, err)
-		err = v.Set("KQJkO20.99")
+		err = v.Set("wVxDr20.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("nIauO:20.99")
+		err = v.Set("nsfUr:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]float32", v.Type())
@@ -8535,9 +8537,9 @@ func TestStringFloat32MapValue(t *testing.T) {
 		v := newStringFloat32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("JsJpra")
+		err = v.Set("CFsVya")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("XqyaF:a")
+		err = v.Set("SzWtb:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]float32", v.Type())
@@ -8552,17 +8554,17 @@ func TestIntFloat32MapValue(t *testing.T) {
 		v := newIntFloat32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("610.2")
+		err = v.Set("710.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("3:10.2")
+		err = v.Set("6:10.2")
 		assert.Nil(t, err)
-		err = v.Set("620.99")
+		err = v.Set("520.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("7:20.99")
+		err = v.Set("2:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]float32", v.Type())
@@ -8574,11 +8576,11 @@ func TestIntFloat32MapValue(t *testing.T) {
 		v := newIntFloat32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4a")
+		err = v.Set("1a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("7:a")
+		err = v.Set("4:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]float32", v.Type())
@@ -8593,13 +8595,13 @@ func TestInt8Float32MapValue(t *testing.T) {
 		v := newInt8Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("510.2")
+		err = v.Set("710.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("2:10.2")
+		err = v.Set("0:10.2")
 		assert.Nil(t, err)
-		err = v.Set("620.99")
+		err = v.Set("520.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
@@ -8615,11 +8617,11 @@ func TestInt8Float32MapValue(t *testing.T) {
 		v := newInt8Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2a")
+		err = v.Set("7a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]float32", v.Type())
@@ -8638,13 +8640,13 @@ func TestInt16Float32MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("1:10.2")
+		err = v.Set("7:10.2")
 		assert.Nil(t, err)
-		err = v.Set("420.99")
+		err = v.Set("020.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("1:20.99")
+		err = v.Set("2:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]float32", v.Type())
@@ -8656,11 +8658,11 @@ func TestInt16Float32MapValue(t *testing.T) {
 		v := newInt16Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0a")
+		err = v.Set("1a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("5:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]float32", v.Type())
@@ -8675,17 +8677,17 @@ func TestInt32Float32MapValue(t *testing.T) {
 		v := newInt32Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110.2")
+		err = v.Set("510.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("6:10.2")
+		err = v.Set("2:10.2")
 		assert.Nil(t, err)
-		err = v.Set("220.99")
+		err = v.Set("120.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("7:20.99")
+		err = v.Set("4:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]float32", v.Type())
@@ -8697,11 +8699,11 @@ func TestInt32Float32MapValue(t *testing.T) {
 		v := newInt32Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2a")
+		err = v.Set("6a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("7:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]float32", v.Type())
@@ -8716,17 +8718,17 @@ func TestInt64Float32MapValue(t *testing.T) {
 		v := newInt64Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310.2")
+		err = v.Set("110.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("7:10.2")
+		err = v.Set("6:10.2")
 		assert.Nil(t, err)
-		err = v.Set("120.99")
+		err = v.Set("720.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("0:20.99")
+		err = v.Set("1:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]float32", v.Type())
@@ -8738,11 +8740,11 @@ func TestInt64Float32MapValue(t *testing.T) {
 		v := newInt64Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0a")
+		err = v.Set("6a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("1:a")
+		err = v.Set("3:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]float32", v.Type())
@@ -8757,17 +8759,17 @@ func TestUintFloat32MapValue(t *testing.T) {
 		v := newUintFloat32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210.2")
+		err = v.Set("410.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("0:10.2")
 		assert.Nil(t, err)
-		err = v.Set("520.99")
+		err = v.Set("420.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("0:20.99")
+		err = v.Set("2:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]float32", v.Type())
@@ -8783,7 +8785,7 @@ func TestUintFloat32MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("6:a")
+		err = v.Set("4:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]float32", v.Type())
@@ -8798,13 +8800,13 @@ func TestUint8Float32MapValue(t *testing.T) {
 		v := newUint8Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210.2")
+		err = v.Set("410.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("5:10.2")
+		err = v.Set("1:10.2")
 		assert.Nil(t, err)
-		err = v.Set("020.99")
+		err = v.Set("120.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
@@ -8820,7 +8822,7 @@ func TestUint8Float32MapValue(t *testing.T) {
 		v := newUint8Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4a")
+		err = v.Set("3a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
@@ -8843,13 +8845,13 @@ func TestUint16Float32MapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("7:10.2")
+		err = v.Set("5:10.2")
 		assert.Nil(t, err)
-		err = v.Set("720.99")
+		err = v.Set("220.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("2:20.99")
+		err = v.Set("1:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]float32", v.Type())
@@ -8861,11 +8863,11 @@ func TestUint16Float32MapValue(t *testing.T) {
 		v := newUint16Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3a")
+		err = v.Set("5a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("6:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]float32", v.Type())
@@ -8880,17 +8882,17 @@ func TestUint32Float32MapValue(t *testing.T) {
 		v := newUint32Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310.2")
+		err = v.Set("210.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
-		err = v.Set("5:10.2")
+		err = v.Set("4:10.2")
 		assert.Nil(t, err)
-		err = v.Set("320.99")
+		err = v.Set("220.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("3:20.99")
+		err = v.Set("0:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]float32", v.Type())
@@ -8902,11 +8904,11 @@ func TestUint32Float32MapValue(t *testing.T) {
 		v := newUint32Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5a")
+		err = v.Set("0a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("2:a")
+		err = v.Set("4:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]float32", v.Type())
@@ -8921,17 +8923,17 @@ func TestUint64Float32MapValue(t *testing.T) {
 		v := newUint64Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("110.2")
+		err = v.Set("710.2")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10.2")
 		assert.NotNil(t, err)
 		err = v.Set("0:10.2")
 		assert.Nil(t, err)
-		err = v.Set("020.99")
+		err = v.Set("520.99")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":20.99")
 		assert.NotNil(t, err)
-		err = v.Set("0:20.99")
+		err = v.Set("2:20.99")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]float32", v.Type())
@@ -8943,11 +8945,11 @@ func TestUint64Float32MapValue(t *testing.T) {
 		v := newUint64Float32MapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0a")
+		err = v.Set("7a")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":a")
 		assert.NotNil(t, err)
-		err = v.Set("4:a")
+		err = v.Set("2:a")
 		assert.EqualError(t, err, "strconv.ParseFloat: parsing \"a\": invalid syntax")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]float32", v.Type())
@@ -9133,13 +9135,13 @@ func TestStringDurationMapValue(t *testing.T) {
 		v := newStringDurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("NEpGo10s")
+		err = v.Set("oLEzi10s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("eEhBW:10s")
+		err = v.Set("JiWmA:10s")
 		assert.Nil(t, err)
-		err = v.Set("iFjGW30m")
+		err = v.Set("PKvAT30m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("WPwSP:30m")
+		err = v.Set("djZyE:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]time.Duration", v.Type())
@@ -9151,9 +9153,9 @@ func TestStringDurationMapValue(t *testing.T) {
 		v := newStringDurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("GRWHd3l")
+		err = v.Set("vvmsI3l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("ZqLkq:3l")
+		err = v.Set("DSJeK:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]time.Duration", v.Type())
@@ -9168,17 +9170,17 @@ func TestIntDurationMapValue(t *testing.T) {
 		v := newIntDurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010s")
+		err = v.Set("210s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("2:10s")
+		err = v.Set("5:10s")
 		assert.Nil(t, err)
-		err = v.Set("430m")
+		err = v.Set("130m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("4:30m")
+		err = v.Set("7:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]time.Duration", v.Type())
@@ -9190,11 +9192,11 @@ func TestIntDurationMapValue(t *testing.T) {
 		v := newIntDurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("13l")
+		err = v.Set("63l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("3:3l")
+		err = v.Set("0:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]time.Duration", v.Type())
@@ -9209,17 +9211,17 @@ func TestInt8DurationMapValue(t *testing.T) {
 		v := newInt8DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010s")
+		err = v.Set("510s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("7:10s")
+		err = v.Set("0:10s")
 		assert.Nil(t, err)
-		err = v.Set("530m")
+		err = v.Set("330m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("4:30m")
+		err = v.Set("0:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]time.Duration", v.Type())
@@ -9231,11 +9233,11 @@ func TestInt8DurationMapValue(t *testing.T) {
 		v := newInt8DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("63l")
+		err = v.Set("53l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("2:3l")
+		err = v.Set("5:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]time.Duration", v.Type())
@@ -9250,17 +9252,17 @@ func TestInt16DurationMapValue(t *testing.T) {
 		v := newInt16DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210s")
+		err = v.Set("510s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("0:10s")
+		err = v.Set("4:10s")
 		assert.Nil(t, err)
-		err = v.Set("130m")
+		err = v.Set("230m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("5:30m")
+		err = v.Set("7:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]time.Duration", v.Type())
@@ -9272,11 +9274,11 @@ func TestInt16DurationMapValue(t *testing.T) {
 		v := newInt16DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("73l")
+		err = v.Set("53l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("7:3l")
+		err = v.Set("1:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]time.Duration", v.Type())
@@ -9291,17 +9293,17 @@ func TestInt32DurationMapValue(t *testing.T) {
 		v := newInt32DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310s")
+		err = v.Set("410s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("2:10s")
+		err = v.Set("7:10s")
 		assert.Nil(t, err)
-		err = v.Set("630m")
+		err = v.Set("530m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("3:30m")
+		err = v.Set("0:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]time.Duration", v.Type())
@@ -9313,11 +9315,11 @@ func TestInt32DurationMapValue(t *testing.T) {
 		v := newInt32DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("73l")
+		err = v.Set("63l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("2:3l")
+		err = v.Set("3:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]time.Duration", v.Type())
@@ -9332,17 +9334,17 @@ func TestInt64DurationMapValue(t *testing.T) {
 		v := newInt64DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("710s")
+		err = v.Set("110s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("0:10s")
+		err = v.Set("3:10s")
 		assert.Nil(t, err)
-		err = v.Set("430m")
+		err = v.Set("130m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("3:30m")
+		err = v.Set("7:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]time.Duration", v.Type())
@@ -9354,11 +9356,11 @@ func TestInt64DurationMapValue(t *testing.T) {
 		v := newInt64DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("53l")
+		err = v.Set("73l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("5:3l")
+		err = v.Set("1:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]time.Duration", v.Type())
@@ -9373,17 +9375,17 @@ func TestUintDurationMapValue(t *testing.T) {
 		v := newUintDurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("210s")
+		err = v.Set("710s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("7:10s")
+		err = v.Set("6:10s")
 		assert.Nil(t, err)
-		err = v.Set("330m")
+		err = v.Set("730m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("5:30m")
+		err = v.Set("4:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]time.Duration", v.Type())
@@ -9399,7 +9401,7 @@ func TestUintDurationMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("4:3l")
+		err = v.Set("3:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]time.Duration", v.Type())
@@ -9414,17 +9416,17 @@ func TestUint8DurationMapValue(t *testing.T) {
 		v := newUint8DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010s")
+		err = v.Set("710s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("5:10s")
+		err = v.Set("2:10s")
 		assert.Nil(t, err)
-		err = v.Set("530m")
+		err = v.Set("730m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("5:30m")
+		err = v.Set("4:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]time.Duration", v.Type())
@@ -9440,7 +9442,7 @@ func TestUint8DurationMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("0:3l")
+		err = v.Set("6:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]time.Duration", v.Type())
@@ -9455,17 +9457,17 @@ func TestUint16DurationMapValue(t *testing.T) {
 		v := newUint16DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("410s")
+		err = v.Set("710s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("3:10s")
+		err = v.Set("4:10s")
 		assert.Nil(t, err)
-		err = v.Set("730m")
+		err = v.Set("230m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("3:30m")
+		err = v.Set("6:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]time.Duration", v.Type())
@@ -9477,11 +9479,11 @@ func TestUint16DurationMapValue(t *testing.T) {
 		v := newUint16DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("43l")
+		err = v.Set("73l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("1:3l")
+		err = v.Set("4:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]time.Duration", v.Type())
@@ -9496,17 +9498,17 @@ func TestUint32DurationMapValue(t *testing.T) {
 		v := newUint32DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("310s")
+		err = v.Set("710s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
-		err = v.Set("0:10s")
+		err = v.Set("2:10s")
 		assert.Nil(t, err)
-		err = v.Set("130m")
+		err = v.Set("630m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("0:30m")
+		err = v.Set("6:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]time.Duration", v.Type())
@@ -9518,11 +9520,11 @@ func TestUint32DurationMapValue(t *testing.T) {
 		v := newUint32DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("13l")
+		err = v.Set("23l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("6:3l")
+		err = v.Set("1:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]time.Duration", v.Type())
@@ -9537,17 +9539,17 @@ func TestUint64DurationMapValue(t *testing.T) {
 		v := newUint64DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("010s")
+		err = v.Set("710s")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":10s")
 		assert.NotNil(t, err)
 		err = v.Set("2:10s")
 		assert.Nil(t, err)
-		err = v.Set("730m")
+		err = v.Set("330m")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":30m")
 		assert.NotNil(t, err)
-		err = v.Set("7:30m")
+		err = v.Set("5:30m")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]time.Duration", v.Type())
@@ -9559,11 +9561,11 @@ func TestUint64DurationMapValue(t *testing.T) {
 		v := newUint64DurationMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("53l")
+		err = v.Set("33l")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":3l")
 		assert.NotNil(t, err)
-		err = v.Set("7:3l")
+		err = v.Set("3:3l")
 		assert.EqualError(t, err, "time: unknown unit \"l\" in duration \"3l\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]time.Duration", v.Type())
@@ -9749,13 +9751,13 @@ func TestStringIPMapValue(t *testing.T) {
 		v := newStringIPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("DdCgV127.0.0.1")
+		err = v.Set("iyeoU127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("jdasN:127.0.0.1")
+		err = v.Set("qzMJG:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("iogwe127.0.0.3")
+		err = v.Set("HOxYT127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("TZEKR:127.0.0.3")
+		err = v.Set("sbfqA:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]net.IP", v.Type())
@@ -9767,9 +9769,9 @@ func TestStringIPMapValue(t *testing.T) {
 		v := newStringIPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("NdqMY127.0.0.1.3")
+		err = v.Set("qtXjk127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("wWHqT:127.0.0.1.3")
+		err = v.Set("VItIB:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]net.IP", v.Type())
@@ -9784,17 +9786,17 @@ func TestIntIPMapValue(t *testing.T) {
 		v := newIntIPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1127.0.0.1")
+		err = v.Set("7127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
-		err = v.Set("3:127.0.0.1")
+		err = v.Set("2:127.0.0.1")
 		assert.Nil(t, err)
 		err = v.Set("6127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("3:127.0.0.3")
+		err = v.Set("5:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]net.IP", v.Type())
@@ -9806,11 +9808,11 @@ func TestIntIPMapValue(t *testing.T) {
 		v := newIntIPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1127.0.0.1.3")
+		err = v.Set("4127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("6:127.0.0.1.3")
+		err = v.Set("1:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]net.IP", v.Type())
@@ -9829,13 +9831,13 @@ func TestInt8IPMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
-		err = v.Set("1:127.0.0.1")
+		err = v.Set("4:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("6127.0.0.3")
+		err = v.Set("1127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("4:127.0.0.3")
+		err = v.Set("5:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]net.IP", v.Type())
@@ -9847,7 +9849,7 @@ func TestInt8IPMapValue(t *testing.T) {
 		v := newInt8IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2127.0.0.1.3")
+		err = v.Set("7127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
@@ -9866,17 +9868,17 @@ func TestInt16IPMapValue(t *testing.T) {
 		v := newInt16IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3127.0.0.1")
+		err = v.Set("4127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
-		err = v.Set("7:127.0.0.1")
+		err = v.Set("1:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("7127.0.0.3")
+		err = v.Set("2127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("3:127.0.0.3")
+		err = v.Set("1:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]net.IP", v.Type())
@@ -9888,11 +9890,11 @@ func TestInt16IPMapValue(t *testing.T) {
 		v := newInt16IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2127.0.0.1.3")
+		err = v.Set("5127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("0:127.0.0.1.3")
+		err = v.Set("6:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]net.IP", v.Type())
@@ -9907,17 +9909,17 @@ func TestInt32IPMapValue(t *testing.T) {
 		v := newInt32IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3127.0.0.1")
+		err = v.Set("2127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
-		err = v.Set("7:127.0.0.1")
+		err = v.Set("3:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("6127.0.0.3")
+		err = v.Set("5127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("6:127.0.0.3")
+		err = v.Set("2:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]net.IP", v.Type())
@@ -9929,11 +9931,11 @@ func TestInt32IPMapValue(t *testing.T) {
 		v := newInt32IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4127.0.0.1.3")
+		err = v.Set("7127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("5:127.0.0.1.3")
+		err = v.Set("2:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]net.IP", v.Type())
@@ -9948,17 +9950,17 @@ func TestInt64IPMapValue(t *testing.T) {
 		v := newInt64IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6127.0.0.1")
+		err = v.Set("4127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("7:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("3127.0.0.3")
+		err = v.Set("7127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("1:127.0.0.3")
+		err = v.Set("2:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]net.IP", v.Type())
@@ -9970,11 +9972,11 @@ func TestInt64IPMapValue(t *testing.T) {
 		v := newInt64IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4127.0.0.1.3")
+		err = v.Set("6127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("7:127.0.0.1.3")
+		err = v.Set("2:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]net.IP", v.Type())
@@ -9989,17 +9991,17 @@ func TestUintIPMapValue(t *testing.T) {
 		v := newUintIPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0127.0.0.1")
+		err = v.Set("1127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
 		err = v.Set("2:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("3127.0.0.3")
+		err = v.Set("7127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("7:127.0.0.3")
+		err = v.Set("1:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]net.IP", v.Type())
@@ -10011,11 +10013,11 @@ func TestUintIPMapValue(t *testing.T) {
 		v := newUintIPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6127.0.0.1.3")
+		err = v.Set("2127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("5:127.0.0.1.3")
+		err = v.Set("6:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]net.IP", v.Type())
@@ -10030,13 +10032,13 @@ func TestUint8IPMapValue(t *testing.T) {
 		v := newUint8IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3127.0.0.1")
+		err = v.Set("5127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
-		err = v.Set("1:127.0.0.1")
+		err = v.Set("6:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("4127.0.0.3")
+		err = v.Set("5127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
@@ -10052,11 +10054,11 @@ func TestUint8IPMapValue(t *testing.T) {
 		v := newUint8IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3127.0.0.1.3")
+		err = v.Set("2127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("6:127.0.0.1.3")
+		err = v.Set("2:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]net.IP", v.Type())
@@ -10071,17 +10073,17 @@ func TestUint16IPMapValue(t *testing.T) {
 		v := newUint16IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4127.0.0.1")
+		err = v.Set("3127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
-		err = v.Set("1:127.0.0.1")
+		err = v.Set("0:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("5127.0.0.3")
+		err = v.Set("3127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("6:127.0.0.3")
+		err = v.Set("4:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]net.IP", v.Type())
@@ -10093,11 +10095,11 @@ func TestUint16IPMapValue(t *testing.T) {
 		v := newUint16IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4127.0.0.1.3")
+		err = v.Set("2127.0.0.1.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("4:127.0.0.1.3")
+		err = v.Set("0:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]net.IP", v.Type())
@@ -10112,17 +10114,17 @@ func TestUint32IPMapValue(t *testing.T) {
 		v := newUint32IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2127.0.0.1")
+		err = v.Set("1127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
-		err = v.Set("3:127.0.0.1")
+		err = v.Set("6:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("1127.0.0.3")
+		err = v.Set("2127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("0:127.0.0.3")
+		err = v.Set("1:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]net.IP", v.Type())
@@ -10138,7 +10140,7 @@ func TestUint32IPMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("0:127.0.0.1.3")
+		err = v.Set("5:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]net.IP", v.Type())
@@ -10153,17 +10155,17 @@ func TestUint64IPMapValue(t *testing.T) {
 		v := newUint64IPMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3127.0.0.1")
+		err = v.Set("7127.0.0.1")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1")
 		assert.NotNil(t, err)
-		err = v.Set("7:127.0.0.1")
+		err = v.Set("1:127.0.0.1")
 		assert.Nil(t, err)
-		err = v.Set("3127.0.0.3")
+		err = v.Set("2127.0.0.3")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.3")
 		assert.NotNil(t, err)
-		err = v.Set("4:127.0.0.3")
+		err = v.Set("7:127.0.0.3")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]net.IP", v.Type())
@@ -10179,7 +10181,7 @@ func TestUint64IPMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":127.0.0.1.3")
 		assert.NotNil(t, err)
-		err = v.Set("6:127.0.0.1.3")
+		err = v.Set("7:127.0.0.1.3")
 		assert.EqualError(t, err, "failed to parse IP: \"127.0.0.1.3\"")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]net.IP", v.Type())
@@ -10385,13 +10387,13 @@ func TestStringHexBytesMapValue(t *testing.T) {
 		v := newStringHexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("EhOkpff")
+		err = v.Set("OxKmRff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("DBBqp:ff")
+		err = v.Set("NwKDO:ff")
 		assert.Nil(t, err)
-		err = v.Set("KvNtYaa")
+		err = v.Set("UnXzmaa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("hiUsJ:aa")
+		err = v.Set("DikdJ:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]HexBytes", v.Type())
@@ -10403,9 +10405,9 @@ func TestStringHexBytesMapValue(t *testing.T) {
 		v := newStringHexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("kkgXhgg")
+		err = v.Set("PukQLgg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("saopk:gg")
+		err = v.Set("axAHz:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]HexBytes", v.Type())
@@ -10420,17 +10422,17 @@ func TestIntHexBytesMapValue(t *testing.T) {
 		v := newIntHexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5ff")
+		err = v.Set("3ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
-		err = v.Set("5:ff")
+		err = v.Set("4:ff")
 		assert.Nil(t, err)
-		err = v.Set("6aa")
+		err = v.Set("5aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
-		err = v.Set("1:aa")
+		err = v.Set("6:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]HexBytes", v.Type())
@@ -10442,11 +10444,11 @@ func TestIntHexBytesMapValue(t *testing.T) {
 		v := newIntHexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2gg")
+		err = v.Set("7gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
-		err = v.Set("5:gg")
+		err = v.Set("7:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]HexBytes", v.Type())
@@ -10461,17 +10463,17 @@ func TestInt8HexBytesMapValue(t *testing.T) {
 		v := newInt8HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5ff")
+		err = v.Set("4ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("0:ff")
 		assert.Nil(t, err)
-		err = v.Set("5aa")
+		err = v.Set("3aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
-		err = v.Set("5:aa")
+		err = v.Set("6:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]HexBytes", v.Type())
@@ -10483,7 +10485,7 @@ func TestInt8HexBytesMapValue(t *testing.T) {
 		v := newInt8HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7gg")
+		err = v.Set("1gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
@@ -10502,17 +10504,17 @@ func TestInt16HexBytesMapValue(t *testing.T) {
 		v := newInt16HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3ff")
+		err = v.Set("4ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
-		err = v.Set("5:ff")
+		err = v.Set("1:ff")
 		assert.Nil(t, err)
-		err = v.Set("0aa")
+		err = v.Set("2aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
-		err = v.Set("7:aa")
+		err = v.Set("6:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]HexBytes", v.Type())
@@ -10524,11 +10526,11 @@ func TestInt16HexBytesMapValue(t *testing.T) {
 		v := newInt16HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0gg")
+		err = v.Set("6gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
-		err = v.Set("4:gg")
+		err = v.Set("2:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]HexBytes", v.Type())
@@ -10543,17 +10545,17 @@ func TestInt32HexBytesMapValue(t *testing.T) {
 		v := newInt32HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4ff")
+		err = v.Set("2ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
-		err = v.Set("2:ff")
+		err = v.Set("6:ff")
 		assert.Nil(t, err)
-		err = v.Set("1aa")
+		err = v.Set("4aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
-		err = v.Set("2:aa")
+		err = v.Set("4:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]HexBytes", v.Type())
@@ -10565,11 +10567,11 @@ func TestInt32HexBytesMapValue(t *testing.T) {
 		v := newInt32HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0gg")
+		err = v.Set("7gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
-		err = v.Set("7:gg")
+		err = v.Set("3:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]HexBytes", v.Type())
@@ -10584,17 +10586,17 @@ func TestInt64HexBytesMapValue(t *testing.T) {
 		v := newInt64HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4ff")
+		err = v.Set("6ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
-		err = v.Set("3:ff")
+		err = v.Set("5:ff")
 		assert.Nil(t, err)
-		err = v.Set("1aa")
+		err = v.Set("0aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
-		err = v.Set("6:aa")
+		err = v.Set("5:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]HexBytes", v.Type())
@@ -10606,11 +10608,11 @@ func TestInt64HexBytesMapValue(t *testing.T) {
 		v := newInt64HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5gg")
+		err = v.Set("2gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
-		err = v.Set("4:gg")
+		err = v.Set("6:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]HexBytes", v.Type())
@@ -10629,9 +10631,9 @@ func TestUintHexBytesMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
-		err = v.Set("0:ff")
+		err = v.Set("1:ff")
 		assert.Nil(t, err)
-		err = v.Set("2aa")
+		err = v.Set("7aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
@@ -10647,11 +10649,11 @@ func TestUintHexBytesMapValue(t *testing.T) {
 		v := newUintHexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5gg")
+		err = v.Set("4gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
-		err = v.Set("3:gg")
+		err = v.Set("6:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]HexBytes", v.Type())
@@ -10666,11 +10668,11 @@ func TestUint8HexBytesMapValue(t *testing.T) {
 		v := newUint8HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1ff")
+		err = v.Set("2ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
-		err = v.Set("5:ff")
+		err = v.Set("1:ff")
 		assert.Nil(t, err)
 		err = v.Set("5aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
@@ -10688,11 +10690,11 @@ func TestUint8HexBytesMapValue(t *testing.T) {
 		v := newUint8HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2gg")
+		err = v.Set("4gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
-		err = v.Set("7:gg")
+		err = v.Set("5:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]HexBytes", v.Type())
@@ -10707,17 +10709,17 @@ func TestUint16HexBytesMapValue(t *testing.T) {
 		v := newUint16HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1ff")
+		err = v.Set("3ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("4:ff")
 		assert.Nil(t, err)
-		err = v.Set("7aa")
+		err = v.Set("6aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
-		err = v.Set("1:aa")
+		err = v.Set("5:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]HexBytes", v.Type())
@@ -10729,11 +10731,11 @@ func TestUint16HexBytesMapValue(t *testing.T) {
 		v := newUint16HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4gg")
+		err = v.Set("3gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
-		err = v.Set("7:gg")
+		err = v.Set("6:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]HexBytes", v.Type())
@@ -10748,17 +10750,17 @@ func TestUint32HexBytesMapValue(t *testing.T) {
 		v := newUint32HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6ff")
+		err = v.Set("0ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
-		err = v.Set("3:ff")
+		err = v.Set("7:ff")
 		assert.Nil(t, err)
-		err = v.Set("2aa")
+		err = v.Set("5aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
-		err = v.Set("7:aa")
+		err = v.Set("6:aa")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]HexBytes", v.Type())
@@ -10770,7 +10772,7 @@ func TestUint32HexBytesMapValue(t *testing.T) {
 		v := newUint32HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3gg")
+		err = v.Set("6gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
@@ -10789,13 +10791,13 @@ func TestUint64HexBytesMapValue(t *testing.T) {
 		v := newUint64HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5ff")
+		err = v.Set("1ff")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":ff")
 		assert.NotNil(t, err)
 		err = v.Set("2:ff")
 		assert.Nil(t, err)
-		err = v.Set("0aa")
+		err = v.Set("6aa")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":aa")
 		assert.NotNil(t, err)
@@ -10811,11 +10813,11 @@ func TestUint64HexBytesMapValue(t *testing.T) {
 		v := newUint64HexBytesMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0gg")
+		err = v.Set("5gg")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":gg")
 		assert.NotNil(t, err)
-		err = v.Set("2:gg")
+		err = v.Set("3:gg")
 		assert.EqualError(t, err, "encoding/hex: invalid byte: U+0067 'g'")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]HexBytes", v.Type())
@@ -11001,13 +11003,13 @@ func TestStringRegexpMapValue(t *testing.T) {
 		v := newStringRegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("qeCtzabc.*")
+		err = v.Set("LLBLbabc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("DJzqr:abc.*")
+		err = v.Set("uEhZF:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("pUjJsxyz.*")
+		err = v.Set("xQuvAxyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("QjTUj:xyz.*")
+		err = v.Set("pLIPk:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]*regexp.Regexp", v.Type())
@@ -11019,9 +11021,9 @@ func TestStringRegexpMapValue(t *testing.T) {
 		v := newStringRegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("gwoKA[abc")
+		err = v.Set("PzXDP[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("UAOGv:[abc")
+		err = v.Set("izdhp:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]*regexp.Regexp", v.Type())
@@ -11036,17 +11038,17 @@ func TestIntRegexpMapValue(t *testing.T) {
 		v := newIntRegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4abc.*")
+		err = v.Set("2abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
-		err = v.Set("5:abc.*")
+		err = v.Set("3:abc.*")
 		assert.Nil(t, err)
 		err = v.Set("2xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("6:xyz.*")
+		err = v.Set("1:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]*regexp.Regexp", v.Type())
@@ -11058,11 +11060,11 @@ func TestIntRegexpMapValue(t *testing.T) {
 		v := newIntRegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2[abc")
+		err = v.Set("0[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("5:[abc")
+		err = v.Set("7:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]*regexp.Regexp", v.Type())
@@ -11077,17 +11079,17 @@ func TestInt8RegexpMapValue(t *testing.T) {
 		v := newInt8RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6abc.*")
+		err = v.Set("0abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("4:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("3xyz.*")
+		err = v.Set("2xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("3:xyz.*")
+		err = v.Set("7:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]*regexp.Regexp", v.Type())
@@ -11099,11 +11101,11 @@ func TestInt8RegexpMapValue(t *testing.T) {
 		v := newInt8RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("6[abc")
+		err = v.Set("0[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("4:[abc")
+		err = v.Set("0:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]*regexp.Regexp", v.Type())
@@ -11118,17 +11120,17 @@ func TestInt16RegexpMapValue(t *testing.T) {
 		v := newInt16RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2abc.*")
+		err = v.Set("5abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
-		err = v.Set("3:abc.*")
+		err = v.Set("0:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("7xyz.*")
+		err = v.Set("3xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("5:xyz.*")
+		err = v.Set("3:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]*regexp.Regexp", v.Type())
@@ -11140,7 +11142,7 @@ func TestInt16RegexpMapValue(t *testing.T) {
 		v := newInt16RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0[abc")
+		err = v.Set("2[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
@@ -11159,17 +11161,17 @@ func TestInt32RegexpMapValue(t *testing.T) {
 		v := newInt32RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2abc.*")
+		err = v.Set("3abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
-		err = v.Set("2:abc.*")
+		err = v.Set("6:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("4xyz.*")
+		err = v.Set("2xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("1:xyz.*")
+		err = v.Set("4:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]*regexp.Regexp", v.Type())
@@ -11181,11 +11183,11 @@ func TestInt32RegexpMapValue(t *testing.T) {
 		v := newInt32RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4[abc")
+		err = v.Set("7[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("5:[abc")
+		err = v.Set("2:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]*regexp.Regexp", v.Type())
@@ -11200,17 +11202,17 @@ func TestInt64RegexpMapValue(t *testing.T) {
 		v := newInt64RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1abc.*")
+		err = v.Set("2abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
 		err = v.Set("4:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("7xyz.*")
+		err = v.Set("4xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("5:xyz.*")
+		err = v.Set("7:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]*regexp.Regexp", v.Type())
@@ -11226,7 +11228,7 @@ func TestInt64RegexpMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("1:[abc")
+		err = v.Set("0:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]*regexp.Regexp", v.Type())
@@ -11241,17 +11243,17 @@ func TestUintRegexpMapValue(t *testing.T) {
 		v := newUintRegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5abc.*")
+		err = v.Set("1abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
-		err = v.Set("7:abc.*")
+		err = v.Set("5:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("4xyz.*")
+		err = v.Set("6xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("7:xyz.*")
+		err = v.Set("4:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]*regexp.Regexp", v.Type())
@@ -11263,11 +11265,11 @@ func TestUintRegexpMapValue(t *testing.T) {
 		v := newUintRegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0[abc")
+		err = v.Set("2[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("3:[abc")
+		err = v.Set("2:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]*regexp.Regexp", v.Type())
@@ -11282,17 +11284,17 @@ func TestUint8RegexpMapValue(t *testing.T) {
 		v := newUint8RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("2abc.*")
+		err = v.Set("1abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
-		err = v.Set("3:abc.*")
+		err = v.Set("0:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("3xyz.*")
+		err = v.Set("6xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("7:xyz.*")
+		err = v.Set("5:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]*regexp.Regexp", v.Type())
@@ -11304,11 +11306,11 @@ func TestUint8RegexpMapValue(t *testing.T) {
 		v := newUint8RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4[abc")
+		err = v.Set("5[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("3:[abc")
+		err = v.Set("5:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]*regexp.Regexp", v.Type())
@@ -11323,17 +11325,17 @@ func TestUint16RegexpMapValue(t *testing.T) {
 		v := newUint16RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("5abc.*")
+		err = v.Set("6abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
-		err = v.Set("4:abc.*")
+		err = v.Set("2:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("3xyz.*")
+		err = v.Set("4xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("6:xyz.*")
+		err = v.Set("1:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]*regexp.Regexp", v.Type())
@@ -11345,11 +11347,11 @@ func TestUint16RegexpMapValue(t *testing.T) {
 		v := newUint16RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("7[abc")
+		err = v.Set("3[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("6:[abc")
+		err = v.Set("3:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]*regexp.Regexp", v.Type())
@@ -11364,17 +11366,17 @@ func TestUint32RegexpMapValue(t *testing.T) {
 		v := newUint32RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("0abc.*")
+		err = v.Set("4abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
-		err = v.Set("0:abc.*")
+		err = v.Set("2:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("4xyz.*")
+		err = v.Set("3xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("5:xyz.*")
+		err = v.Set("6:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]*regexp.Regexp", v.Type())
@@ -11386,11 +11388,11 @@ func TestUint32RegexpMapValue(t *testing.T) {
 		v := newUint32RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("1[abc")
+		err = v.Set("4[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("0:[abc")
+		err = v.Set("3:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]*regexp.Regexp", v.Type())
@@ -11405,17 +11407,17 @@ func TestUint64RegexpMapValue(t *testing.T) {
 		v := newUint64RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("3abc.*")
+		err = v.Set("0abc.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":abc.*")
 		assert.NotNil(t, err)
-		err = v.Set("2:abc.*")
+		err = v.Set("6:abc.*")
 		assert.Nil(t, err)
-		err = v.Set("5xyz.*")
+		err = v.Set("3xyz.*")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":xyz.*")
 		assert.NotNil(t, err)
-		err = v.Set("1:xyz.*")
+		err = v.Set("5:xyz.*")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]*regexp.Regexp", v.Type())
@@ -11427,11 +11429,11 @@ func TestUint64RegexpMapValue(t *testing.T) {
 		v := newUint64RegexpMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("4[abc")
+		err = v.Set("2[abc")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":[abc")
 		assert.NotNil(t, err)
-		err = v.Set("6:[abc")
+		err = v.Set("4:[abc")
 		assert.EqualError(t, err, "error parsing regexp: missing closing ]: `[abc`")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]*regexp.Regexp", v.Type())
@@ -11749,13 +11751,13 @@ func TestStringIPNetMapValue(t *testing.T) {
 		v := newStringIPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("IGYfs0.0.0.0/0")
+		err = v.Set("EqCuP0.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("mxFfO:0.0.0.0/0")
+		err = v.Set("DMNeu:0.0.0.0/0")
 		assert.Nil(t, err)
-		err = v.Set("ELWWJ255.255.255.255/19")
+		err = v.Set("iKTxR255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("SMCaq:255.255.255.255/19")
+		err = v.Set("INKSz:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]net.IPNet", v.Type())
@@ -11767,9 +11769,9 @@ func TestStringIPNetMapValue(t *testing.T) {
 		v := newStringIPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("bcOjd0.0.0.256/16")
+		err = v.Set("jAIvx0.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
-		err = v.Set("mhCxJ:0.0.0.256/16")
+		err = v.Set("BRDRL:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[string]net.IPNet", v.Type())
@@ -11784,13 +11786,13 @@ func TestIntIPNetMapValue(t *testing.T) {
 		v := newIntIPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("10.0.0.0/0")
+		err = v.Set("00.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("3:0.0.0.0/0")
+		err = v.Set("6:0.0.0.0/0")
 		assert.Nil(t, err)
-		err = v.Set("1255.255.255.255/19")
+		err = v.Set("0255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
@@ -11810,7 +11812,7 @@ func TestIntIPNetMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("3:0.0.0.256/16")
+		err = v.Set("4:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int]net.IPNet", v.Type())
@@ -11825,11 +11827,11 @@ func TestInt8IPNetMapValue(t *testing.T) {
 		v := newInt8IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("20.0.0.0/0")
+		err = v.Set("70.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("0:0.0.0.0/0")
+		err = v.Set("6:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("6255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
@@ -11847,11 +11849,11 @@ func TestInt8IPNetMapValue(t *testing.T) {
 		v := newInt8IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("60.0.0.256/16")
+		err = v.Set("10.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("1:0.0.0.256/16")
+		err = v.Set("7:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int8]net.IPNet", v.Type())
@@ -11866,17 +11868,17 @@ func TestInt16IPNetMapValue(t *testing.T) {
 		v := newInt16IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("70.0.0.0/0")
+		err = v.Set("10.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("3:0.0.0.0/0")
+		err = v.Set("0:0.0.0.0/0")
 		assert.Nil(t, err)
-		err = v.Set("7255.255.255.255/19")
+		err = v.Set("5255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
-		err = v.Set("3:255.255.255.255/19")
+		err = v.Set("7:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]net.IPNet", v.Type())
@@ -11888,11 +11890,11 @@ func TestInt16IPNetMapValue(t *testing.T) {
 		v := newInt16IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("10.0.0.256/16")
+		err = v.Set("40.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("7:0.0.0.256/16")
+		err = v.Set("2:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int16]net.IPNet", v.Type())
@@ -11907,17 +11909,17 @@ func TestInt32IPNetMapValue(t *testing.T) {
 		v := newInt32IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("70.0.0.0/0")
+		err = v.Set("00.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("3:0.0.0.0/0")
+		err = v.Set("2:0.0.0.0/0")
 		assert.Nil(t, err)
-		err = v.Set("1255.255.255.255/19")
+		err = v.Set("7255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
-		err = v.Set("5:255.255.255.255/19")
+		err = v.Set("1:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]net.IPNet", v.Type())
@@ -11929,11 +11931,11 @@ func TestInt32IPNetMapValue(t *testing.T) {
 		v := newInt32IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("70.0.0.256/16")
+		err = v.Set("10.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("3:0.0.0.256/16")
+		err = v.Set("2:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int32]net.IPNet", v.Type())
@@ -11948,17 +11950,17 @@ func TestInt64IPNetMapValue(t *testing.T) {
 		v := newInt64IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("40.0.0.0/0")
+		err = v.Set("10.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("4:0.0.0.0/0")
+		err = v.Set("5:0.0.0.0/0")
 		assert.Nil(t, err)
-		err = v.Set("2255.255.255.255/19")
+		err = v.Set("5255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
-		err = v.Set("3:255.255.255.255/19")
+		err = v.Set("2:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]net.IPNet", v.Type())
@@ -11970,11 +11972,11 @@ func TestInt64IPNetMapValue(t *testing.T) {
 		v := newInt64IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("40.0.0.256/16")
+		err = v.Set("10.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("5:0.0.0.256/16")
+		err = v.Set("1:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[int64]net.IPNet", v.Type())
@@ -11989,17 +11991,17 @@ func TestUintIPNetMapValue(t *testing.T) {
 		v := newUintIPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("70.0.0.0/0")
+		err = v.Set("40.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("4:0.0.0.0/0")
+		err = v.Set("7:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("1255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
-		err = v.Set("4:255.255.255.255/19")
+		err = v.Set("7:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]net.IPNet", v.Type())
@@ -12011,11 +12013,11 @@ func TestUintIPNetMapValue(t *testing.T) {
 		v := newUintIPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("10.0.0.256/16")
+		err = v.Set("60.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("0:0.0.0.256/16")
+		err = v.Set("2:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint]net.IPNet", v.Type())
@@ -12030,17 +12032,17 @@ func TestUint8IPNetMapValue(t *testing.T) {
 		v := newUint8IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("10.0.0.0/0")
+		err = v.Set("70.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
 		err = v.Set("1:0.0.0.0/0")
 		assert.Nil(t, err)
-		err = v.Set("7255.255.255.255/19")
+		err = v.Set("4255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
-		err = v.Set("1:255.255.255.255/19")
+		err = v.Set("5:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]net.IPNet", v.Type())
@@ -12052,11 +12054,11 @@ func TestUint8IPNetMapValue(t *testing.T) {
 		v := newUint8IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("40.0.0.256/16")
+		err = v.Set("10.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("5:0.0.0.256/16")
+		err = v.Set("6:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint8]net.IPNet", v.Type())
@@ -12071,17 +12073,17 @@ func TestUint16IPNetMapValue(t *testing.T) {
 		v := newUint16IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("50.0.0.0/0")
+		err = v.Set("40.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("6:0.0.0.0/0")
+		err = v.Set("7:0.0.0.0/0")
 		assert.Nil(t, err)
-		err = v.Set("0255.255.255.255/19")
+		err = v.Set("6255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
-		err = v.Set("3:255.255.255.255/19")
+		err = v.Set("2:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]net.IPNet", v.Type())
@@ -12097,7 +12099,7 @@ func TestUint16IPNetMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("5:0.0.0.256/16")
+		err = v.Set("4:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint16]net.IPNet", v.Type())
@@ -12112,17 +12114,17 @@ func TestUint32IPNetMapValue(t *testing.T) {
 		v := newUint32IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("10.0.0.0/0")
+		err = v.Set("70.0.0.0/0")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("7:0.0.0.0/0")
+		err = v.Set("4:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("1255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
-		err = v.Set("6:255.255.255.255/19")
+		err = v.Set("2:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]net.IPNet", v.Type())
@@ -12134,11 +12136,11 @@ func TestUint32IPNetMapValue(t *testing.T) {
 		v := newUint32IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("40.0.0.256/16")
+		err = v.Set("50.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("0:0.0.0.256/16")
+		err = v.Set("5:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint32]net.IPNet", v.Type())
@@ -12157,13 +12159,13 @@ func TestUint64IPNetMapValue(t *testing.T) {
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.0/0")
 		assert.NotNil(t, err)
-		err = v.Set("7:0.0.0.0/0")
+		err = v.Set("1:0.0.0.0/0")
 		assert.Nil(t, err)
 		err = v.Set("2255.255.255.255/19")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":255.255.255.255/19")
 		assert.NotNil(t, err)
-		err = v.Set("5:255.255.255.255/19")
+		err = v.Set("7:255.255.255.255/19")
 		assert.Nil(t, err)
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]net.IPNet", v.Type())
@@ -12175,11 +12177,11 @@ func TestUint64IPNetMapValue(t *testing.T) {
 		v := newUint64IPNetMapValue(&a)
 		assert.Equal(t, parseGeneratedMap(&a), v)
 		assert.True(t, v.IsCumulative())
-		err = v.Set("30.0.0.256/16")
+		err = v.Set("50.0.0.256/16")
 		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
 		err = v.Set(":0.0.0.256/16")
 		assert.NotNil(t, err)
-		err = v.Set("3:0.0.0.256/16")
+		err = v.Set("1:0.0.0.256/16")
 		assert.EqualError(t, err, "invalid CIDR address: 0.0.0.256/16")
 		assert.Equal(t, a, v.Get())
 		assert.Equal(t, "map[uint64]net.IPNet", v.Type())
@@ -12187,6 +12189,4222 @@ func TestUint64IPNetMapValue(t *testing.T) {
 	})
 }
 
+func TestURLValue_Zero(t *testing.T) {
+	nilValue := new(urlValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*urlValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestURLValue(t *testing.T) {
+	t.Run("in: https://example.com:8080/path?q=1", func(t *testing.T) {
+		a := new(url.URL)
+		v := newURLValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("https://example.com:8080/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com:8080/path?q=1", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "url", v.Type())
+	})
+	t.Run("in: /relative/path", func(t *testing.T) {
+		a := new(url.URL)
+		v := newURLValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("/relative/path")
+		assert.Nil(t, err)
+		assert.Equal(t, "/relative/path", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "url", v.Type())
+	})
+	t.Run("in: ://example.com", func(t *testing.T) {
+		a := new(url.URL)
+		v := newURLValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("://example.com")
+		assert.EqualError(t, err, "parse \"://example.com\": missing protocol scheme")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "url", v.Type())
+	})
+
+}
+
+func TestURLSliceValue_Zero(t *testing.T) {
+	nilValue := new(urlSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*urlSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringURLMapValue_Zero(t *testing.T) {
+	var nilValue stringURLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringURLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntURLMapValue_Zero(t *testing.T) {
+	var nilValue intURLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intURLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8URLMapValue_Zero(t *testing.T) {
+	var nilValue int8URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16URLMapValue_Zero(t *testing.T) {
+	var nilValue int16URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32URLMapValue_Zero(t *testing.T) {
+	var nilValue int32URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64URLMapValue_Zero(t *testing.T) {
+	var nilValue int64URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintURLMapValue_Zero(t *testing.T) {
+	var nilValue uintURLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintURLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8URLMapValue_Zero(t *testing.T) {
+	var nilValue uint8URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16URLMapValue_Zero(t *testing.T) {
+	var nilValue uint16URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32URLMapValue_Zero(t *testing.T) {
+	var nilValue uint32URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64URLMapValue_Zero(t *testing.T) {
+	var nilValue uint64URLMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64URLMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestURLSliceValue(t *testing.T) {
+	t.Run("in: [http://a.com,https://b.com ftp://c.com]", func(t *testing.T) {
+		var err error
+		a := new([]url.URL)
+		v := newURLSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("http://a.com,https://b.com")
+		assert.Nil(t, err)
+		err = v.Set("ftp://c.com")
+		assert.Nil(t, err)
+		assert.Equal(t, "[http://a.com,https://b.com,ftp://c.com]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "urlSlice", v.Type())
+	})
+	t.Run("in: [http://a.com,://b.com]", func(t *testing.T) {
+		var err error
+		a := new([]url.URL)
+		v := newURLSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("http://a.com,://b.com")
+		assert.EqualError(t, err, "parse \"://b.com\": missing protocol scheme")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "urlSlice", v.Type())
+	})
+
+}
+
+func TestStringURLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[string]url.URL)
+		v := newStringURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("ryhmSexample.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("laOSS:example.com")
+		assert.Nil(t, err)
+		err = v.Set("UVsZs/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("mHKaN:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[string]url.URL)
+		v := newStringURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("geqzC%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("ZeWGR:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntURLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[int]url.URL)
+		v := newIntURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("5:example.com")
+		assert.Nil(t, err)
+		err = v.Set("4/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("0:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[int]url.URL)
+		v := newIntURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("4:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8URLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]url.URL)
+		v := newInt8URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("3:example.com")
+		assert.Nil(t, err)
+		err = v.Set("6/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("2:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]url.URL)
+		v := newInt8URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("2:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16URLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]url.URL)
+		v := newInt16URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("4:example.com")
+		assert.Nil(t, err)
+		err = v.Set("3/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("5:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]url.URL)
+		v := newInt16URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("5:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32URLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]url.URL)
+		v := newInt32URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("6:example.com")
+		assert.Nil(t, err)
+		err = v.Set("5/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("7:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]url.URL)
+		v := newInt32URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("0:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64URLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]url.URL)
+		v := newInt64URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("7:example.com")
+		assert.Nil(t, err)
+		err = v.Set("1/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("3:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]url.URL)
+		v := newInt64URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("0:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintURLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]url.URL)
+		v := newUintURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("6:example.com")
+		assert.Nil(t, err)
+		err = v.Set("4/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("0:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]url.URL)
+		v := newUintURLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("1:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8URLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]url.URL)
+		v := newUint8URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("0:example.com")
+		assert.Nil(t, err)
+		err = v.Set("7/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("1:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]url.URL)
+		v := newUint8URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("1:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16URLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]url.URL)
+		v := newUint16URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("5:example.com")
+		assert.Nil(t, err)
+		err = v.Set("4/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("1:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]url.URL)
+		v := newUint16URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("4:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32URLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]url.URL)
+		v := newUint32URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("2:example.com")
+		assert.Nil(t, err)
+		err = v.Set("4/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("1:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]url.URL)
+		v := newUint32URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("3:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64URLMapValue(t *testing.T) {
+	t.Run("in: [example.com /path?q=1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]url.URL)
+		v := newUint64URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0example.com")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":example.com")
+		assert.NotNil(t, err)
+		err = v.Set("4:example.com")
+		assert.Nil(t, err)
+		err = v.Set("2/path?q=1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":/path?q=1")
+		assert.NotNil(t, err)
+		err = v.Set("6:/path?q=1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]url.URL", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [%zz]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]url.URL)
+		v := newUint64URLMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6%zz")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":%zz")
+		assert.NotNil(t, err)
+		err = v.Set("5:%zz")
+		assert.EqualError(t, err, "parse \"%zz\": invalid URL escape \"%zz\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]url.URL", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestByteSizeValue_Zero(t *testing.T) {
+	nilValue := new(byteSizeValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*byteSizeValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestByteSizeValue(t *testing.T) {
+	t.Run("in: 512", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("512")
+		assert.Nil(t, err)
+		assert.Equal(t, "512B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 10KB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10KB")
+		assert.Nil(t, err)
+		assert.Equal(t, "10KB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 1.5GiB", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, "1.5GiB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 2048kib", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("2048kib")
+		assert.Nil(t, err)
+		assert.Equal(t, "2MiB", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+	t.Run("in: 1x", func(t *testing.T) {
+		a := new(ByteSize)
+		v := newByteSizeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, "0B", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSize", v.Type())
+	})
+
+}
+
+func TestByteSizeSliceValue_Zero(t *testing.T) {
+	nilValue := new(byteSizeSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*byteSizeSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue stringByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue intByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue int8ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue int16ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue int32ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue int64ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uintByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uint8ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uint16ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uint32ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64ByteSizeMapValue_Zero(t *testing.T) {
+	var nilValue uint64ByteSizeMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64ByteSizeMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestByteSizeSliceValue(t *testing.T) {
+	t.Run("in: [1KB,2KiB 3MB]", func(t *testing.T) {
+		var err error
+		a := new([]ByteSize)
+		v := newByteSizeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1KB,2KiB")
+		assert.Nil(t, err)
+		err = v.Set("3MB")
+		assert.Nil(t, err)
+		assert.Equal(t, "[1KB,2KiB,3MB]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSizeSlice", v.Type())
+	})
+	t.Run("in: [1KB,GB]", func(t *testing.T) {
+		var err error
+		a := new([]ByteSize)
+		v := newByteSizeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1KB,GB")
+		assert.EqualError(t, err, "invalid byte size \"GB\"")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "byteSizeSlice", v.Type())
+	})
+
+}
+
+func TestStringByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[string]ByteSize)
+		v := newStringByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("OkZlI10KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("UNSsC:10KB")
+		assert.Nil(t, err)
+		err = v.Set("raKQB1.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("rSfkM:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[string]ByteSize)
+		v := newStringByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("AIStK1x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("MupnC:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[int]ByteSize)
+		v := newIntByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("5:10KB")
+		assert.Nil(t, err)
+		err = v.Set("61.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("1:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[int]ByteSize)
+		v := newIntByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("0:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]ByteSize)
+		v := newInt8ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("4:10KB")
+		assert.Nil(t, err)
+		err = v.Set("01.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("4:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]ByteSize)
+		v := newInt8ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("71x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("0:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]ByteSize)
+		v := newInt16ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("410KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("5:10KB")
+		assert.Nil(t, err)
+		err = v.Set("21.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("7:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]ByteSize)
+		v := newInt16ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("21x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("7:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]ByteSize)
+		v := newInt32ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("110KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("7:10KB")
+		assert.Nil(t, err)
+		err = v.Set("21.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("6:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]ByteSize)
+		v := newInt32ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("01x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("7:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]ByteSize)
+		v := newInt64ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("2:10KB")
+		assert.Nil(t, err)
+		err = v.Set("61.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("4:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]ByteSize)
+		v := newInt64ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("4:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]ByteSize)
+		v := newUintByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("410KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("7:10KB")
+		assert.Nil(t, err)
+		err = v.Set("01.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("7:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]ByteSize)
+		v := newUintByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("01x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("5:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]ByteSize)
+		v := newUint8ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("710KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("0:10KB")
+		assert.Nil(t, err)
+		err = v.Set("01.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("0:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]ByteSize)
+		v := newUint8ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("61x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("2:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]ByteSize)
+		v := newUint16ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("010KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("1:10KB")
+		assert.Nil(t, err)
+		err = v.Set("51.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("7:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]ByteSize)
+		v := newUint16ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("01x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("6:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]ByteSize)
+		v := newUint32ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("4:10KB")
+		assert.Nil(t, err)
+		err = v.Set("61.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("1:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]ByteSize)
+		v := newUint32ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("21x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("1:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64ByteSizeMapValue(t *testing.T) {
+	t.Run("in: [10KB 1.5GiB]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]ByteSize)
+		v := newUint64ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("110KB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10KB")
+		assert.NotNil(t, err)
+		err = v.Set("5:10KB")
+		assert.Nil(t, err)
+		err = v.Set("21.5GiB")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1.5GiB")
+		assert.NotNil(t, err)
+		err = v.Set("6:1.5GiB")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]ByteSize", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]ByteSize)
+		v := newUint64ByteSizeMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("41x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1x")
+		assert.NotNil(t, err)
+		err = v.Set("4:1x")
+		assert.EqualError(t, err, "invalid byte size \"1x\": unknown unit \"x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]ByteSize", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestRateValue_Zero(t *testing.T) {
+	nilValue := new(rateValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*rateValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestRateValue(t *testing.T) {
+	t.Run("in: 100/s", func(t *testing.T) {
+		a := new(Rate)
+		v := newRateValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("100/s")
+		assert.Nil(t, err)
+		assert.Equal(t, "100/s", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "rate", v.Type())
+	})
+	t.Run("in: 10MB/s", func(t *testing.T) {
+		a := new(Rate)
+		v := newRateValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10MB/s")
+		assert.Nil(t, err)
+		assert.Equal(t, "10MB/s", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "rate", v.Type())
+	})
+	t.Run("in: 5/10m", func(t *testing.T) {
+		a := new(Rate)
+		v := newRateValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("5/10m")
+		assert.Nil(t, err)
+		assert.Equal(t, "5/10m0s", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "rate", v.Type())
+	})
+	t.Run("in: 1.5/1h", func(t *testing.T) {
+		a := new(Rate)
+		v := newRateValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1.5/1h")
+		assert.Nil(t, err)
+		assert.Equal(t, "1.5/h", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "rate", v.Type())
+	})
+	t.Run("in: 100", func(t *testing.T) {
+		a := new(Rate)
+		v := newRateValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("100")
+		assert.EqualError(t, err, "invalid rate \"100\", use amount/period, e.g. 100/s")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "rate", v.Type())
+	})
+
+}
+
+func TestRateSliceValue_Zero(t *testing.T) {
+	nilValue := new(rateSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*rateSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringRateMapValue_Zero(t *testing.T) {
+	var nilValue stringRateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringRateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntRateMapValue_Zero(t *testing.T) {
+	var nilValue intRateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intRateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8RateMapValue_Zero(t *testing.T) {
+	var nilValue int8RateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8RateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16RateMapValue_Zero(t *testing.T) {
+	var nilValue int16RateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16RateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32RateMapValue_Zero(t *testing.T) {
+	var nilValue int32RateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32RateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64RateMapValue_Zero(t *testing.T) {
+	var nilValue int64RateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64RateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintRateMapValue_Zero(t *testing.T) {
+	var nilValue uintRateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintRateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8RateMapValue_Zero(t *testing.T) {
+	var nilValue uint8RateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8RateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16RateMapValue_Zero(t *testing.T) {
+	var nilValue uint16RateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16RateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32RateMapValue_Zero(t *testing.T) {
+	var nilValue uint32RateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32RateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64RateMapValue_Zero(t *testing.T) {
+	var nilValue uint64RateMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64RateMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestRateSliceValue(t *testing.T) {
+	t.Run("in: [100/s,10MB/s 1/m]", func(t *testing.T) {
+		var err error
+		a := new([]Rate)
+		v := newRateSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("100/s,10MB/s")
+		assert.Nil(t, err)
+		err = v.Set("1/m")
+		assert.Nil(t, err)
+		assert.Equal(t, "[100/s,10MB/s,1/m]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "rateSlice", v.Type())
+	})
+	t.Run("in: [1/s,100]", func(t *testing.T) {
+		var err error
+		a := new([]Rate)
+		v := newRateSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1/s,100")
+		assert.EqualError(t, err, "invalid rate \"100\", use amount/period, e.g. 100/s")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "rateSlice", v.Type())
+	})
+
+}
+
+func TestStringRateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[string]Rate)
+		v := newStringRateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("JSYZB100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("jGdAe:100/s")
+		assert.Nil(t, err)
+		err = v.Set("QxmvL10MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("Hqeei:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[string]Rate)
+		v := newStringRateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("zRbUe1/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("sPXmQ:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntRateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[int]Rate)
+		v := newIntRateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("0:100/s")
+		assert.Nil(t, err)
+		err = v.Set("110MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("2:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[int]Rate)
+		v := newIntRateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("0:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8RateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]Rate)
+		v := newInt8RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("5:100/s")
+		assert.Nil(t, err)
+		err = v.Set("210MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("7:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]Rate)
+		v := newInt8RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("6:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16RateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]Rate)
+		v := newInt16RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("0:100/s")
+		assert.Nil(t, err)
+		err = v.Set("210MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("0:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]Rate)
+		v := newInt16RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("51/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("5:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32RateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]Rate)
+		v := newInt32RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("3:100/s")
+		assert.Nil(t, err)
+		err = v.Set("710MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("2:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]Rate)
+		v := newInt32RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("01/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("6:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64RateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]Rate)
+		v := newInt64RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("0:100/s")
+		assert.Nil(t, err)
+		err = v.Set("510MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("7:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]Rate)
+		v := newInt64RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("7:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintRateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]Rate)
+		v := newUintRateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("1:100/s")
+		assert.Nil(t, err)
+		err = v.Set("410MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("7:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]Rate)
+		v := newUintRateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("41/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("5:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8RateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]Rate)
+		v := newUint8RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("2:100/s")
+		assert.Nil(t, err)
+		err = v.Set("610MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("7:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]Rate)
+		v := newUint8RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("6:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16RateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]Rate)
+		v := newUint16RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("2:100/s")
+		assert.Nil(t, err)
+		err = v.Set("010MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("3:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]Rate)
+		v := newUint16RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("31/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("0:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32RateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]Rate)
+		v := newUint32RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("4:100/s")
+		assert.Nil(t, err)
+		err = v.Set("610MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("0:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]Rate)
+		v := newUint32RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("61/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("2:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64RateMapValue(t *testing.T) {
+	t.Run("in: [100/s 10MiB/h]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]Rate)
+		v := newUint64RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0100/s")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":100/s")
+		assert.NotNil(t, err)
+		err = v.Set("2:100/s")
+		assert.Nil(t, err)
+		err = v.Set("010MiB/h")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10MiB/h")
+		assert.NotNil(t, err)
+		err = v.Set("0:10MiB/h")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]Rate", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [1/x]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]Rate)
+		v := newUint64RateMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("41/x")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":1/x")
+		assert.NotNil(t, err)
+		err = v.Set("7:1/x")
+		assert.EqualError(t, err, "invalid rate \"1/x\": time: unknown unit \"x\" in duration \"1x\"")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]Rate", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestExistingFileValue_Zero(t *testing.T) {
+	nilValue := new(existingFileValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*existingFileValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestExistingFileValue(t *testing.T) {
+	t.Run("in: values.go", func(t *testing.T) {
+		a := new(ExistingFile)
+		v := newExistingFileValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("values.go")
+		assert.Nil(t, err)
+		assert.Equal(t, "values.go", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingFile", v.Type())
+	})
+	t.Run("in: cmd", func(t *testing.T) {
+		a := new(ExistingFile)
+		v := newExistingFileValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("cmd")
+		assert.EqualError(t, err, "\"cmd\" is a directory")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingFile", v.Type())
+	})
+	t.Run("in: nofile", func(t *testing.T) {
+		a := new(ExistingFile)
+		v := newExistingFileValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("nofile")
+		assert.EqualError(t, err, "stat nofile: no such file or directory")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingFile", v.Type())
+	})
+
+}
+
+func TestExistingFileSliceValue_Zero(t *testing.T) {
+	nilValue := new(existingFileSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*existingFileSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestExistingFileSliceValue(t *testing.T) {
+	t.Run("in: [values.go,parser.go flag.go]", func(t *testing.T) {
+		var err error
+		a := new([]ExistingFile)
+		v := newExistingFileSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("values.go,parser.go")
+		assert.Nil(t, err)
+		err = v.Set("flag.go")
+		assert.Nil(t, err)
+		assert.Equal(t, "[values.go,parser.go,flag.go]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingFileSlice", v.Type())
+	})
+	t.Run("in: [values.go,nofile]", func(t *testing.T) {
+		var err error
+		a := new([]ExistingFile)
+		v := newExistingFileSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("values.go,nofile")
+		assert.EqualError(t, err, "stat nofile: no such file or directory")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingFileSlice", v.Type())
+	})
+
+}
+
+func TestExistingDirValue_Zero(t *testing.T) {
+	nilValue := new(existingDirValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*existingDirValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestExistingDirValue(t *testing.T) {
+	t.Run("in: cmd", func(t *testing.T) {
+		a := new(ExistingDir)
+		v := newExistingDirValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("cmd")
+		assert.Nil(t, err)
+		assert.Equal(t, "cmd", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingDir", v.Type())
+	})
+	t.Run("in: values.go", func(t *testing.T) {
+		a := new(ExistingDir)
+		v := newExistingDirValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("values.go")
+		assert.EqualError(t, err, "\"values.go\" is not a directory")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingDir", v.Type())
+	})
+	t.Run("in: nodir", func(t *testing.T) {
+		a := new(ExistingDir)
+		v := newExistingDirValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("nodir")
+		assert.EqualError(t, err, "stat nodir: no such file or directory")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingDir", v.Type())
+	})
+
+}
+
+func TestExistingDirSliceValue_Zero(t *testing.T) {
+	nilValue := new(existingDirSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*existingDirSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestExistingDirSliceValue(t *testing.T) {
+	t.Run("in: [cmd,gen examples]", func(t *testing.T) {
+		var err error
+		a := new([]ExistingDir)
+		v := newExistingDirSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("cmd,gen")
+		assert.Nil(t, err)
+		err = v.Set("examples")
+		assert.Nil(t, err)
+		assert.Equal(t, "[cmd,gen,examples]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingDirSlice", v.Type())
+	})
+	t.Run("in: [cmd,nodir]", func(t *testing.T) {
+		var err error
+		a := new([]ExistingDir)
+		v := newExistingDirSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("cmd,nodir")
+		assert.EqualError(t, err, "stat nodir: no such file or directory")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "existingDirSlice", v.Type())
+	})
+
+}
+
+func TestFileValue_Zero(t *testing.T) {
+	nilValue := new(fileValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*fileValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestFileValue(t *testing.T) {
+	t.Run("in: values.go", func(t *testing.T) {
+		a := new(File)
+		v := newFileValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("values.go")
+		assert.Nil(t, err)
+		assert.Equal(t, "values.go", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "file", v.Type())
+	})
+	t.Run("in: -", func(t *testing.T) {
+		a := new(File)
+		v := newFileValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("-")
+		assert.Nil(t, err)
+		assert.Equal(t, "-", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "file", v.Type())
+	})
+	t.Run("in: cmd", func(t *testing.T) {
+		a := new(File)
+		v := newFileValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("cmd")
+		assert.EqualError(t, err, "\"cmd\" is a directory")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "file", v.Type())
+	})
+	t.Run("in: nofile", func(t *testing.T) {
+		a := new(File)
+		v := newFileValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("nofile")
+		assert.EqualError(t, err, "stat nofile: no such file or directory")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "file", v.Type())
+	})
+
+}
+
+func TestFileSliceValue_Zero(t *testing.T) {
+	nilValue := new(fileSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*fileSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestFileSliceValue(t *testing.T) {
+	t.Run("in: [values.go,- flag.go]", func(t *testing.T) {
+		var err error
+		a := new([]File)
+		v := newFileSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("values.go,-")
+		assert.Nil(t, err)
+		err = v.Set("flag.go")
+		assert.Nil(t, err)
+		assert.Equal(t, "[values.go,-,flag.go]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "fileSlice", v.Type())
+	})
+	t.Run("in: [values.go,nofile]", func(t *testing.T) {
+		var err error
+		a := new([]File)
+		v := newFileSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("values.go,nofile")
+		assert.EqualError(t, err, "stat nofile: no such file or directory")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "fileSlice", v.Type())
+	})
+
+}
+
+func TestTimeValue_Zero(t *testing.T) {
+	nilValue := new(timeValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*timeValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestTimeValue(t *testing.T) {
+	t.Run("in: 2024-01-02T03:04:05Z", func(t *testing.T) {
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("2024-01-02T03:04:05Z")
+		assert.Nil(t, err)
+		assert.Equal(t, "2024-01-02T03:04:05Z", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+	t.Run("in: 2024-01-02T03:04:05+02:00", func(t *testing.T) {
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("2024-01-02T03:04:05+02:00")
+		assert.Nil(t, err)
+		assert.Equal(t, "2024-01-02T03:04:05+02:00", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+	t.Run("in: 1700000000", func(t *testing.T) {
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("1700000000")
+		assert.Nil(t, err)
+		assert.Equal(t, "2023-11-14T22:13:20Z", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+	t.Run("in: ", func(t *testing.T) {
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("")
+		assert.Nil(t, err)
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+	t.Run("in: yesterday", func(t *testing.T) {
+		a := new(time.Time)
+		v := newTimeValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("yesterday")
+		assert.EqualError(t, err, "invalid time \"yesterday\", use \"2006-01-02T15:04:05Z07:00\" layout, now-1h or unix timestamp")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "time", v.Type())
+	})
+
+}
+
+func TestTimeSliceValue_Zero(t *testing.T) {
+	nilValue := new(timeSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*timeSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestTimeSliceValue(t *testing.T) {
+	t.Run("in: [2024-01-02T03:04:05Z,1700000000 0]", func(t *testing.T) {
+		var err error
+		a := new([]time.Time)
+		v := newTimeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2024-01-02T03:04:05Z,1700000000")
+		assert.Nil(t, err)
+		err = v.Set("0")
+		assert.Nil(t, err)
+		assert.Equal(t, "[2024-01-02T03:04:05Z,2023-11-14T22:13:20Z,1970-01-01T00:00:00Z]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "timeSlice", v.Type())
+	})
+	t.Run("in: [0,now-]", func(t *testing.T) {
+		var err error
+		a := new([]time.Time)
+		v := newTimeSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0,now-")
+		assert.EqualError(t, err, "invalid time \"now-\", use \"2006-01-02T15:04:05Z07:00\" layout, now-1h or unix timestamp")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "timeSlice", v.Type())
+	})
+
+}
+
+func TestAddrValue_Zero(t *testing.T) {
+	nilValue := new(addrValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*addrValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestAddrValue(t *testing.T) {
+	t.Run("in: 127.0.0.1", func(t *testing.T) {
+		a := new(netip.Addr)
+		v := newAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("127.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, "127.0.0.1", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addr", v.Type())
+	})
+	t.Run("in: ::1", func(t *testing.T) {
+		a := new(netip.Addr)
+		v := newAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("::1")
+		assert.Nil(t, err)
+		assert.Equal(t, "::1", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addr", v.Type())
+	})
+	t.Run("in: localhost", func(t *testing.T) {
+		a := new(netip.Addr)
+		v := newAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addr", v.Type())
+	})
+	t.Run("in: 256.0.0.1", func(t *testing.T) {
+		a := new(netip.Addr)
+		v := newAddrValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("256.0.0.1")
+		assert.EqualError(t, err, "ParseAddr(\"256.0.0.1\"): IPv4 field has value >255")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addr", v.Type())
+	})
+
+}
+
+func TestAddrSliceValue_Zero(t *testing.T) {
+	nilValue := new(addrSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*addrSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringAddrMapValue_Zero(t *testing.T) {
+	var nilValue stringAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntAddrMapValue_Zero(t *testing.T) {
+	var nilValue intAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8AddrMapValue_Zero(t *testing.T) {
+	var nilValue int8AddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8AddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16AddrMapValue_Zero(t *testing.T) {
+	var nilValue int16AddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16AddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32AddrMapValue_Zero(t *testing.T) {
+	var nilValue int32AddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32AddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64AddrMapValue_Zero(t *testing.T) {
+	var nilValue int64AddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64AddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintAddrMapValue_Zero(t *testing.T) {
+	var nilValue uintAddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintAddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8AddrMapValue_Zero(t *testing.T) {
+	var nilValue uint8AddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8AddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16AddrMapValue_Zero(t *testing.T) {
+	var nilValue uint16AddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16AddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32AddrMapValue_Zero(t *testing.T) {
+	var nilValue uint32AddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32AddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64AddrMapValue_Zero(t *testing.T) {
+	var nilValue uint64AddrMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64AddrMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestAddrSliceValue(t *testing.T) {
+	t.Run("in: [127.0.0.1,::1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := new([]netip.Addr)
+		v := newAddrSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1,::1")
+		assert.Nil(t, err)
+		err = v.Set("10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, "[127.0.0.1,::1,10.0.0.1]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addrSlice", v.Type())
+	})
+	t.Run("in: [127.0.0.1,localhost]", func(t *testing.T) {
+		var err error
+		a := new([]netip.Addr)
+		v := newAddrSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1,localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addrSlice", v.Type())
+	})
+
+}
+
+func TestStringAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.Addr)
+		v := newStringAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("edjZt127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("rpZTl:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("jvjyN10.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("ubgyU:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.Addr)
+		v := newStringAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("rETWdlocalhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("tOoPu:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.Addr)
+		v := newIntAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("710.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.Addr)
+		v := newIntAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("3:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8AddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.Addr)
+		v := newInt8AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("1:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("710.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.Addr)
+		v := newInt8AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("0:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16AddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.Addr)
+		v := newInt16AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("010.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.Addr)
+		v := newInt16AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("5:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32AddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.Addr)
+		v := newInt32AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("7:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("510.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.Addr)
+		v := newInt32AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("0:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64AddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.Addr)
+		v := newInt64AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("7:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("710.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("6:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.Addr)
+		v := newInt64AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("1:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintAddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.Addr)
+		v := newUintAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("110.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.Addr)
+		v := newUintAddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("2:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8AddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.Addr)
+		v := newUint8AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("410.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("3:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.Addr)
+		v := newUint8AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("6:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16AddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.Addr)
+		v := newUint16AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("510.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.Addr)
+		v := newUint16AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("2:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32AddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.Addr)
+		v := newUint32AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("210.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.Addr)
+		v := newUint32AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("0:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64AddrMapValue(t *testing.T) {
+	t.Run("in: [127.0.0.1 10.0.0.1]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.Addr)
+		v := newUint64AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("3127.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":127.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("0:127.0.0.1")
+		assert.Nil(t, err)
+		err = v.Set("210.0.0.1")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.1")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.Addr", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.Addr)
+		v := newUint64AddrMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("1:localhost")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.Addr", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestPrefixValue_Zero(t *testing.T) {
+	nilValue := new(prefixValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*prefixValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestPrefixValue(t *testing.T) {
+	t.Run("in: 10.0.0.0/8", func(t *testing.T) {
+		a := new(netip.Prefix)
+		v := newPrefixValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10.0.0.0/8")
+		assert.Nil(t, err)
+		assert.Equal(t, "10.0.0.0/8", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "prefix", v.Type())
+	})
+	t.Run("in: 2001:db8::/32", func(t *testing.T) {
+		a := new(netip.Prefix)
+		v := newPrefixValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("2001:db8::/32")
+		assert.Nil(t, err)
+		assert.Equal(t, "2001:db8::/32", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "prefix", v.Type())
+	})
+	t.Run("in: 10.0.0.0", func(t *testing.T) {
+		a := new(netip.Prefix)
+		v := newPrefixValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "prefix", v.Type())
+	})
+	t.Run("in: 10.0.0.0/33", func(t *testing.T) {
+		a := new(netip.Prefix)
+		v := newPrefixValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("10.0.0.0/33")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0/33\"): prefix length out of range")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "prefix", v.Type())
+	})
+
+}
+
+func TestPrefixSliceValue_Zero(t *testing.T) {
+	nilValue := new(prefixSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*prefixSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringPrefixMapValue_Zero(t *testing.T) {
+	var nilValue stringPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntPrefixMapValue_Zero(t *testing.T) {
+	var nilValue intPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8PrefixMapValue_Zero(t *testing.T) {
+	var nilValue int8PrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8PrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16PrefixMapValue_Zero(t *testing.T) {
+	var nilValue int16PrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16PrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32PrefixMapValue_Zero(t *testing.T) {
+	var nilValue int32PrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32PrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64PrefixMapValue_Zero(t *testing.T) {
+	var nilValue int64PrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64PrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintPrefixMapValue_Zero(t *testing.T) {
+	var nilValue uintPrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintPrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8PrefixMapValue_Zero(t *testing.T) {
+	var nilValue uint8PrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8PrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16PrefixMapValue_Zero(t *testing.T) {
+	var nilValue uint16PrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16PrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32PrefixMapValue_Zero(t *testing.T) {
+	var nilValue uint32PrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32PrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64PrefixMapValue_Zero(t *testing.T) {
+	var nilValue uint64PrefixMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64PrefixMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestPrefixSliceValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8,192.168.0.0/16 ::/0]", func(t *testing.T) {
+		var err error
+		a := new([]netip.Prefix)
+		v := newPrefixSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("10.0.0.0/8,192.168.0.0/16")
+		assert.Nil(t, err)
+		err = v.Set("::/0")
+		assert.Nil(t, err)
+		assert.Equal(t, "[10.0.0.0/8,192.168.0.0/16,::/0]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "prefixSlice", v.Type())
+	})
+	t.Run("in: [10.0.0.0/8,10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := new([]netip.Prefix)
+		v := newPrefixSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("10.0.0.0/8,10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "prefixSlice", v.Type())
+	})
+
+}
+
+func TestStringPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.Prefix)
+		v := newStringPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("CePNn10.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("imQoC:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("NrtHH192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("ZSHVy:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.Prefix)
+		v := newStringPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("IifKq10.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("RwXVB:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.Prefix)
+		v := newIntPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("7:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("5192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("3:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.Prefix)
+		v := newIntPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("0:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8PrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.Prefix)
+		v := newInt8PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("010.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("1:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("2192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("5:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.Prefix)
+		v := newInt8PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("4:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16PrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.Prefix)
+		v := newInt16PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("1:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("2192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("1:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.Prefix)
+		v := newInt16PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("710.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("6:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32PrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.Prefix)
+		v := newInt32PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("410.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("3192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("7:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.Prefix)
+		v := newInt32PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("510.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64PrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.Prefix)
+		v := newInt64PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("410.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("0:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("6192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("7:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.Prefix)
+		v := newInt64PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("110.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintPrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.Prefix)
+		v := newUintPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("7:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("7192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("3:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.Prefix)
+		v := newUintPrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("6:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8PrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.Prefix)
+		v := newUint8PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("2:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("2192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("2:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.Prefix)
+		v := newUint8PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("210.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16PrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.Prefix)
+		v := newUint16PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("010.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("5:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("2192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("0:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.Prefix)
+		v := newUint16PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("110.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("4:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32PrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.Prefix)
+		v := newUint32PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("610.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("1:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("0192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("0:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.Prefix)
+		v := newUint32PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("4:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64PrefixMapValue(t *testing.T) {
+	t.Run("in: [10.0.0.0/8 192.168.0.0/16]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.Prefix)
+		v := newUint64PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("010.0.0.0/8")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0/8")
+		assert.NotNil(t, err)
+		err = v.Set("4:10.0.0.0/8")
+		assert.Nil(t, err)
+		err = v.Set("7192.168.0.0/16")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":192.168.0.0/16")
+		assert.NotNil(t, err)
+		err = v.Set("7:192.168.0.0/16")
+		assert.Nil(t, err)
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.Prefix", v.Type())
+		assert.NotEmpty(t, v.String())
+	})
+	t.Run("in: [10.0.0.0]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.Prefix)
+		v := newUint64PrefixMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("310.0.0.0")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":10.0.0.0")
+		assert.NotNil(t, err)
+		err = v.Set("3:10.0.0.0")
+		assert.EqualError(t, err, "netip.ParsePrefix(\"10.0.0.0\"): no '/'")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.Prefix", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestAddrPortValue_Zero(t *testing.T) {
+	nilValue := new(addrPortValue)
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*addrPortValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestAddrPortValue(t *testing.T) {
+	t.Run("in: 127.0.0.1:80", func(t *testing.T) {
+		a := new(netip.AddrPort)
+		v := newAddrPortValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("127.0.0.1:80")
+		assert.Nil(t, err)
+		assert.Equal(t, "127.0.0.1:80", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addrPort", v.Type())
+	})
+	t.Run("in: [::1]:443", func(t *testing.T) {
+		a := new(netip.AddrPort)
+		v := newAddrPortValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("[::1]:443")
+		assert.Nil(t, err)
+		assert.Equal(t, "[::1]:443", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addrPort", v.Type())
+	})
+	t.Run("in: localhost:80", func(t *testing.T) {
+		a := new(netip.AddrPort)
+		v := newAddrPortValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("localhost:80")
+		assert.EqualError(t, err, "ParseAddr(\"localhost\"): unable to parse IP")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addrPort", v.Type())
+	})
+	t.Run("in: 127.0.0.1", func(t *testing.T) {
+		a := new(netip.AddrPort)
+		v := newAddrPortValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		err := v.Set("127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, "", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addrPort", v.Type())
+	})
+
+}
+
+func TestAddrPortSliceValue_Zero(t *testing.T) {
+	nilValue := new(addrPortSliceValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*addrPortSliceValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestStringAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue stringAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue intAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8AddrPortMapValue_Zero(t *testing.T) {
+	var nilValue int8AddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8AddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16AddrPortMapValue_Zero(t *testing.T) {
+	var nilValue int16AddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16AddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32AddrPortMapValue_Zero(t *testing.T) {
+	var nilValue int32AddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32AddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64AddrPortMapValue_Zero(t *testing.T) {
+	var nilValue int64AddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64AddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintAddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uintAddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintAddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8AddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uint8AddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8AddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16AddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uint16AddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16AddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32AddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uint32AddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32AddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64AddrPortMapValue_Zero(t *testing.T) {
+	var nilValue uint64AddrPortMapValue
+	assert.Equal(t, "", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64AddrPortMapValue)(nil)
+	assert.Equal(t, "", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestAddrPortSliceValue(t *testing.T) {
+	t.Run("in: [127.0.0.1:80,[::1]:443 10.0.0.1:8080]", func(t *testing.T) {
+		var err error
+		a := new([]netip.AddrPort)
+		v := newAddrPortSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1:80,[::1]:443")
+		assert.Nil(t, err)
+		err = v.Set("10.0.0.1:8080")
+		assert.Nil(t, err)
+		assert.Equal(t, "[127.0.0.1:80,[::1]:443,10.0.0.1:8080]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addrPortSlice", v.Type())
+	})
+	t.Run("in: [127.0.0.1:80,127.0.0.1]", func(t *testing.T) {
+		var err error
+		a := new([]netip.AddrPort)
+		v := newAddrPortSliceValue(a)
+		assert.Equal(t, parseGenerated(a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("127.0.0.1:80,127.0.0.1")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, "[]", v.String())
+		assert.Equal(t, *a, v.Get())
+		assert.Equal(t, "addrPortSlice", v.Type())
+	})
+
+}
+
+func TestStringAddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[string]netip.AddrPort)
+		v := newStringAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("GMnLVlocalhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set("gpITB:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[string]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestIntAddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int]netip.AddrPort)
+		v := newIntAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("7:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt8AddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int8]netip.AddrPort)
+		v := newInt8AddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("4localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("7:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int8]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt16AddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int16]netip.AddrPort)
+		v := newInt16AddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("7localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("7:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int16]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt32AddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int32]netip.AddrPort)
+		v := newInt32AddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("1localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("6:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int32]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestInt64AddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[int64]netip.AddrPort)
+		v := newInt64AddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("2localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("2:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[int64]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUintAddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint]netip.AddrPort)
+		v := newUintAddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("0:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint8AddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint8]netip.AddrPort)
+		v := newUint8AddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("7:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint8]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint16AddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint16]netip.AddrPort)
+		v := newUint16AddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("0localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("0:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint16]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint32AddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint32]netip.AddrPort)
+		v := newUint32AddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("5localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("2:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint32]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestUint64AddrPortMapValue(t *testing.T) {
+	t.Run("in: [localhost]", func(t *testing.T) {
+		var err error
+		a := make(map[uint64]netip.AddrPort)
+		v := newUint64AddrPortMapValue(&a)
+		assert.Equal(t, parseGeneratedMap(&a), v)
+		assert.True(t, v.IsCumulative())
+		err = v.Set("6localhost")
+		assert.EqualError(t, err, "invalid map flag syntax, use -map=key1:val1")
+		err = v.Set(":localhost")
+		assert.NotNil(t, err)
+		err = v.Set("1:localhost")
+		assert.EqualError(t, err, "not an ip:port")
+		assert.Equal(t, a, v.Get())
+		assert.Equal(t, "map[uint64]netip.AddrPort", v.Type())
+		assert.Empty(t, v.String())
+	})
+}
+
+func TestStringArrayValue_Zero(t *testing.T) {
+	nilValue := new(stringArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*stringArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestBoolArrayValue_Zero(t *testing.T) {
+	nilValue := new(boolArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*boolArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUintArrayValue_Zero(t *testing.T) {
+	nilValue := new(uintArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uintArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint8ArrayValue_Zero(t *testing.T) {
+	nilValue := new(uint8ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint8ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint16ArrayValue_Zero(t *testing.T) {
+	nilValue := new(uint16ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint16ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint32ArrayValue_Zero(t *testing.T) {
+	nilValue := new(uint32ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint32ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestUint64ArrayValue_Zero(t *testing.T) {
+	nilValue := new(uint64ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*uint64ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIntArrayValue_Zero(t *testing.T) {
+	nilValue := new(intArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*intArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt8ArrayValue_Zero(t *testing.T) {
+	nilValue := new(int8ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int8ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt16ArrayValue_Zero(t *testing.T) {
+	nilValue := new(int16ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int16ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt32ArrayValue_Zero(t *testing.T) {
+	nilValue := new(int32ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int32ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestInt64ArrayValue_Zero(t *testing.T) {
+	nilValue := new(int64ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*int64ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestFloat64ArrayValue_Zero(t *testing.T) {
+	nilValue := new(float64ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*float64ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestFloat32ArrayValue_Zero(t *testing.T) {
+	nilValue := new(float32ArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*float32ArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestDurationArrayValue_Zero(t *testing.T) {
+	nilValue := new(durationArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*durationArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIPArrayValue_Zero(t *testing.T) {
+	nilValue := new(ipArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*ipArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestHexBytesArrayValue_Zero(t *testing.T) {
+	nilValue := new(hexBytesArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*hexBytesArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestRegexpArrayValue_Zero(t *testing.T) {
+	nilValue := new(regexpArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*regexpArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestTCPAddrArrayValue_Zero(t *testing.T) {
+	nilValue := new(tcpAddrArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*tcpAddrArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestIPNetArrayValue_Zero(t *testing.T) {
+	nilValue := new(ipNetArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*ipNetArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestURLArrayValue_Zero(t *testing.T) {
+	nilValue := new(urlArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*urlArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestByteSizeArrayValue_Zero(t *testing.T) {
+	nilValue := new(byteSizeArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*byteSizeArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestRateArrayValue_Zero(t *testing.T) {
+	nilValue := new(rateArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*rateArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestExistingFileArrayValue_Zero(t *testing.T) {
+	nilValue := new(existingFileArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*existingFileArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestExistingDirArrayValue_Zero(t *testing.T) {
+	nilValue := new(existingDirArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*existingDirArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestFileArrayValue_Zero(t *testing.T) {
+	nilValue := new(fileArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*fileArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestTimeArrayValue_Zero(t *testing.T) {
+	nilValue := new(timeArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*timeArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestAddrArrayValue_Zero(t *testing.T) {
+	nilValue := new(addrArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*addrArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestPrefixArrayValue_Zero(t *testing.T) {
+	nilValue := new(prefixArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*prefixArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
+func TestAddrPortArrayValue_Zero(t *testing.T) {
+	nilValue := new(addrPortArrayValue)
+	assert.Equal(t, "[]", nilValue.String())
+	assert.Nil(t, nilValue.Get())
+	nilObj := (*addrPortArrayValue)(nil)
+	assert.Equal(t, "[]", nilObj.String())
+	assert.Nil(t, nilObj.Get())
+}
+
 func TestParseGeneratedMap_NilDefault(t *testing.T) {
 	a := new(bool)
 	v := parseGeneratedMap(a)
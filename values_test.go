@@ -2,9 +2,11 @@ package sflags
 
 import (
 	"fmt"
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCounter_Set(t *testing.T) {
@@ -86,3 +88,21 @@ func TestValidateValue_Set(t *testing.T) {
 	}
 	assert.EqualError(t, v.Set("newVal"), "invalid newVal")
 }
+
+func TestNetipValues_Zero(t *testing.T) {
+	cfg := &struct {
+		Addr     netip.Addr
+		Prefix   netip.Prefix
+		AddrPort netip.AddrPort
+		Listen   netip.AddrPort
+	}{
+		Listen: netip.MustParseAddrPort("127.0.0.1:8080"),
+	}
+	flags, err := ParseStruct(cfg)
+	require.NoError(t, err)
+	require.Len(t, flags, 4)
+	assert.Equal(t, "", flags[0].DefValue)
+	assert.Equal(t, "", flags[1].DefValue)
+	assert.Equal(t, "", flags[2].DefValue)
+	assert.Equal(t, "127.0.0.1:8080", flags[3].DefValue)
+}